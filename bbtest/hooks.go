@@ -0,0 +1,90 @@
+package bbtest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/davidji99/bitbucket-go/bitbucket"
+)
+
+func (s *Server) routeHooks(w http.ResponseWriter, r *http.Request, repo *repository, segments []string) {
+	switch len(segments) {
+	case 0:
+		switch r.Method {
+		case http.MethodGet:
+			writePage(w, r, s.pagelen, repo.hooks)
+		case http.MethodPost:
+			req := new(bitbucket.RepositoryHookRequest)
+			if !decode(w, r, req) {
+				return
+			}
+
+			if req.GetURL() == "" || len(req.Events) == 0 {
+				writeError(w, http.StatusBadRequest, "url and events are required")
+				return
+			}
+
+			hook := &bitbucket.RepositoryHook{
+				UUID:        ptr(s.nextUUID()),
+				SubjectType: ptr("repository"),
+				Active:      ptr(true),
+				CreatedAt:   now(),
+			}
+			applyHookRequest(hook, req)
+			repo.hooks = append(repo.hooks, hook)
+
+			writeJSON(w, http.StatusCreated, hook)
+		default:
+			writeMethodNotAllowed(w)
+		}
+	case 1:
+		for index, hook := range repo.hooks {
+			if hook.GetUUID() != segments[0] {
+				continue
+			}
+
+			switch r.Method {
+			case http.MethodGet:
+				writeJSON(w, http.StatusOK, hook)
+			case http.MethodPut:
+				req := new(bitbucket.RepositoryHookRequest)
+				if !decode(w, r, req) {
+					return
+				}
+
+				applyHookRequest(hook, req)
+
+				writeJSON(w, http.StatusOK, hook)
+			case http.MethodDelete:
+				repo.hooks = append(repo.hooks[:index], repo.hooks[index+1:]...)
+
+				writeNoContent(w)
+			default:
+				writeMethodNotAllowed(w)
+			}
+			return
+		}
+
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Webhook %s not found", segments[0]))
+	default:
+		writeError(w, http.StatusNotFound, "Resource not found")
+	}
+}
+
+func applyHookRequest(hook *bitbucket.RepositoryHook, req *bitbucket.RepositoryHookRequest) {
+	if req.URL != nil {
+		hook.URL = req.URL
+	}
+	if req.Description != nil {
+		hook.Description = req.Description
+	}
+	if req.Active != nil {
+		hook.Active = req.Active
+	}
+	if req.Events != nil {
+		hook.Events = req.Events
+	}
+	if req.Secret != nil {
+		hook.SecretSet = ptr(req.GetSecret() != "")
+	}
+}
@@ -0,0 +1,207 @@
+package bbtest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/davidji99/bitbucket-go/bitbucket"
+)
+
+// issue holds the state of a single issue.
+type issue struct {
+	issue    *bitbucket.Issue
+	comments []*bitbucket.IssueComment
+}
+
+func (s *Server) routeIssues(w http.ResponseWriter, r *http.Request, repo *repository, segments []string) {
+	if len(segments) == 0 {
+		s.handleIssues(w, r, repo)
+		return
+	}
+
+	id, ok := parseID(w, segments[0])
+	if !ok {
+		return
+	}
+
+	var found *issue
+	var index int
+	for i, candidate := range repo.issues {
+		if candidate.issue.GetID() == id {
+			found, index = candidate, i
+		}
+	}
+	if found == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Issue %d not found", id))
+		return
+	}
+
+	switch {
+	case len(segments) == 1:
+		s.handleIssue(w, r, repo, found, index)
+	case segments[1] == "comments" && len(segments) <= 3:
+		s.routeIssueComments(w, r, repo, found, segments[2:])
+	default:
+		writeError(w, http.StatusNotFound, "Resource not found")
+	}
+}
+
+func (s *Server) handleIssues(w http.ResponseWriter, r *http.Request, repo *repository) {
+	switch r.Method {
+	case http.MethodGet:
+		values := make([]*bitbucket.Issue, 0, len(repo.issues))
+		for _, i := range repo.issues {
+			values = append(values, i.issue)
+		}
+
+		writePage(w, r, s.pagelen, values)
+	case http.MethodPost:
+		req := new(bitbucket.IssueRequest)
+		if !decode(w, r, req) {
+			return
+		}
+
+		if req.GetTitle() == "" {
+			writeError(w, http.StatusBadRequest, "title is required")
+			return
+		}
+
+		repo.nextIssueID++
+		created := &bitbucket.Issue{
+			Type:       ptr("issue"),
+			ID:         ptr(repo.nextIssueID),
+			Title:      req.Title,
+			Kind:       ptr("bug"),
+			Priority:   ptr("major"),
+			State:      ptr("new"),
+			Reporter:   s.user,
+			Repository: &bitbucket.Repository{Type: ptr("repository"), FullName: repo.repository.FullName},
+			Content:    &bitbucket.IssueContent{Raw: ptr(""), Markup: ptr("markdown")},
+			Votes:      ptr(0),
+			Watches:    ptr(1),
+			CreatedOn:  now(),
+			UpdatedOn:  now(),
+		}
+		applyIssueRequest(created, req)
+
+		repo.issues = append(repo.issues, &issue{issue: created})
+
+		writeJSON(w, http.StatusCreated, created)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func applyIssueRequest(i *bitbucket.Issue, req *bitbucket.IssueRequest) {
+	if req.Title != nil {
+		i.Title = req.Title
+	}
+	if req.Kind != nil {
+		i.Kind = req.Kind
+	}
+	if req.Priority != nil {
+		i.Priority = req.Priority
+	}
+	if req.Content != nil && req.Content.Raw != nil {
+		i.Content.Raw = req.Content.Raw
+	}
+	if req.Assignee != nil && req.Assignee.Username != nil {
+		i.Assignee = &bitbucket.User{Username: req.Assignee.Username}
+	}
+}
+
+func (s *Server) handleIssue(w http.ResponseWriter, r *http.Request, repo *repository, i *issue, index int) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, i.issue)
+	case http.MethodPut:
+		req := new(bitbucket.IssueRequest)
+		if !decode(w, r, req) {
+			return
+		}
+
+		applyIssueRequest(i.issue, req)
+		i.issue.EditedOn = now()
+		i.issue.UpdatedOn = now()
+
+		writeJSON(w, http.StatusOK, i.issue)
+	case http.MethodDelete:
+		repo.issues = append(repo.issues[:index], repo.issues[index+1:]...)
+
+		writeNoContent(w)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) routeIssueComments(w http.ResponseWriter, r *http.Request, repo *repository, i *issue, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writePage(w, r, s.pagelen, i.comments)
+		case http.MethodPost:
+			req := new(bitbucket.IssueCommentRequest)
+			if !decode(w, r, req) {
+				return
+			}
+
+			if req.GetContent().GetRaw() == "" {
+				writeError(w, http.StatusBadRequest, "content.raw is required")
+				return
+			}
+
+			repo.nextCommentID++
+			comment := &bitbucket.IssueComment{
+				Type:      ptr("issue_comment"),
+				ID:        ptr(repo.nextCommentID),
+				Content:   &bitbucket.Content{Raw: req.Content.Raw, Markup: ptr("markdown")},
+				User:      s.user,
+				Issue:     &bitbucket.Issue{Type: ptr("issue"), ID: i.issue.ID, Title: i.issue.Title},
+				CreatedOn: now(),
+			}
+			i.comments = append(i.comments, comment)
+
+			writeJSON(w, http.StatusCreated, comment)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	id, ok := parseID(w, segments[0])
+	if !ok {
+		return
+	}
+
+	for index, comment := range i.comments {
+		if comment.GetID() != id {
+			continue
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, comment)
+		case http.MethodPut:
+			req := new(bitbucket.IssueCommentRequest)
+			if !decode(w, r, req) {
+				return
+			}
+
+			if req.Content != nil && req.Content.Raw != nil {
+				comment.Content.Raw = req.Content.Raw
+			}
+			comment.UpdatedOn = now()
+
+			writeJSON(w, http.StatusOK, comment)
+		case http.MethodDelete:
+			i.comments = append(i.comments[:index], i.comments[index+1:]...)
+
+			writeNoContent(w)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	writeError(w, http.StatusNotFound, fmt.Sprintf("Comment %d not found", id))
+}
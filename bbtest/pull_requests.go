@@ -0,0 +1,365 @@
+package bbtest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/davidji99/bitbucket-go/bitbucket"
+)
+
+// pullRequest holds the state of a single pull request.
+type pullRequest struct {
+	pullRequest *bitbucket.PullRequest
+	comments    []*bitbucket.PRComment
+}
+
+func (repo *repository) findPullRequest(id int64) *pullRequest {
+	for _, pr := range repo.pullRequests {
+		if pr.pullRequest.GetID() == id {
+			return pr
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) routePullRequests(w http.ResponseWriter, r *http.Request, repo *repository, segments []string) {
+	if len(segments) == 0 {
+		s.handlePullRequests(w, r, repo)
+		return
+	}
+
+	id, ok := parseID(w, segments[0])
+	if !ok {
+		return
+	}
+
+	pr := repo.findPullRequest(id)
+	if pr == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Pull request %d not found", id))
+		return
+	}
+
+	if len(segments) == 1 {
+		s.handlePullRequest(w, r, repo, pr)
+		return
+	}
+
+	switch {
+	case len(segments) == 2 && segments[1] == "approve":
+		s.handlePullRequestReview(w, r, pr, bitbucket.ParticipantStateApproved)
+	case len(segments) == 2 && segments[1] == "request-changes":
+		s.handlePullRequestReview(w, r, pr, bitbucket.ParticipantStateChangesRequested)
+	case len(segments) == 2 && segments[1] == "decline":
+		s.handlePullRequestTransition(w, r, repo, pr, "DECLINED")
+	case len(segments) == 2 && segments[1] == "merge":
+		s.handlePullRequestTransition(w, r, repo, pr, "MERGED")
+	case len(segments) == 2 && segments[1] == "statuses":
+		if r.Method != http.MethodGet {
+			writeMethodNotAllowed(w)
+			return
+		}
+
+		writePage(w, r, s.pagelen, repo.statusesOf(pr.pullRequest.GetSource().GetCommit().GetHash()))
+	case segments[1] == "comments" && len(segments) <= 3:
+		s.routePullRequestComments(w, r, repo, pr, segments[2:])
+	default:
+		writeError(w, http.StatusNotFound, "Resource not found")
+	}
+}
+
+// handlePullRequests lists the pull requests in the states given by the state query parameters,
+// OPEN by default, or creates a pull request.
+func (s *Server) handlePullRequests(w http.ResponseWriter, r *http.Request, repo *repository) {
+	switch r.Method {
+	case http.MethodGet:
+		states := r.URL.Query()["state"]
+		if len(states) == 0 {
+			states = []string{"OPEN"}
+		}
+
+		values := make([]*bitbucket.PullRequest, 0)
+		for _, pr := range repo.pullRequests {
+			for _, state := range states {
+				if pr.pullRequest.GetState() == state {
+					values = append(values, pr.pullRequest)
+					break
+				}
+			}
+		}
+
+		writePage(w, r, s.pagelen, values)
+	case http.MethodPost:
+		req := new(bitbucket.PRRequest)
+		if !decode(w, r, req) {
+			return
+		}
+
+		if req.GetTitle() == "" {
+			writeError(w, http.StatusBadRequest, "title is required")
+			return
+		}
+
+		source := req.GetSource().GetBranch().GetName()
+		sourceRef := findRef(repo.branches, source)
+		if sourceRef == nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("source branch %q not found", source))
+			return
+		}
+
+		destination := req.GetDestination().GetBranch().GetName()
+		if destination == "" {
+			destination = repo.repository.GetMainBranch().GetName()
+		}
+		destinationRef := findRef(repo.branches, destination)
+		if destinationRef == nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("destination branch %q not found", destination))
+			return
+		}
+
+		repo.nextPRID++
+		created := &bitbucket.PullRequest{
+			Type:              ptr("pullrequest"),
+			ID:                ptr(repo.nextPRID),
+			Title:             req.Title,
+			Description:       ptr(req.GetDescription()),
+			State:             ptr("OPEN"),
+			Author:            s.user,
+			Source:            branchOf(repo, sourceRef),
+			Destination:       branchOf(repo, destinationRef),
+			CloseSourceBranch: ptr(req.GetCloseSourceBranch()),
+			CommentCount:      ptr(int64(0)),
+			TaskCount:         ptr(int64(0)),
+			Reviewers:         make([]*bitbucket.User, 0),
+			Participants:      make([]*bitbucket.Participant, 0),
+			CreatedOn:         now(),
+			UpdatedOn:         timestamp(),
+		}
+		for _, reviewer := range req.Reviewers {
+			created.Reviewers = append(created.Reviewers, &bitbucket.User{UUID: reviewer.UUID})
+		}
+
+		repo.pullRequests = append(repo.pullRequests, &pullRequest{pullRequest: created})
+
+		writeJSON(w, http.StatusCreated, created)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func branchOf(repo *repository, ref *bitbucket.Ref) *bitbucket.PullRequestBranch {
+	return &bitbucket.PullRequestBranch{
+		Branch:     &bitbucket.Branch{Name: ref.Name},
+		Commit:     &bitbucket.Commit{Type: ptr("commit"), Hash: ptr(ref.GetTarget().GetHash())},
+		Repository: &bitbucket.Repository{Type: ptr("repository"), FullName: repo.repository.FullName, UUID: repo.repository.UUID},
+	}
+}
+
+func (s *Server) handlePullRequest(w http.ResponseWriter, r *http.Request, repo *repository, pr *pullRequest) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, pr.pullRequest)
+	case http.MethodPut:
+		req := new(bitbucket.PRRequest)
+		if !decode(w, r, req) {
+			return
+		}
+
+		if req.Title != nil {
+			pr.pullRequest.Title = req.Title
+		}
+		if req.Description != nil {
+			pr.pullRequest.Description = req.Description
+		}
+		if req.CloseSourceBranch != nil {
+			pr.pullRequest.CloseSourceBranch = req.CloseSourceBranch
+		}
+		if name := req.GetDestination().GetBranch().GetName(); name != "" {
+			ref := findRef(repo.branches, name)
+			if ref == nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("destination branch %q not found", name))
+				return
+			}
+			pr.pullRequest.Destination = branchOf(repo, ref)
+		}
+		if req.Reviewers != nil {
+			pr.pullRequest.Reviewers = make([]*bitbucket.User, 0)
+			for _, reviewer := range req.Reviewers {
+				pr.pullRequest.Reviewers = append(pr.pullRequest.Reviewers, &bitbucket.User{UUID: reviewer.UUID})
+			}
+		}
+		pr.pullRequest.UpdatedOn = timestamp()
+
+		writeJSON(w, http.StatusOK, pr.pullRequest)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+// handlePullRequestReview approves, or requests changes to, the pull request as the Server's user, or withdraws
+// that review. state is bitbucket.ParticipantStateApproved or bitbucket.ParticipantStateChangesRequested.
+func (s *Server) handlePullRequestReview(w http.ResponseWriter, r *http.Request, pr *pullRequest, state string) {
+	var participant *bitbucket.Participant
+	for _, p := range pr.pullRequest.Participants {
+		if p.GetUser().GetUUID() == s.user.GetUUID() {
+			participant = p
+		}
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		if pr.pullRequest.GetState() != "OPEN" {
+			writeError(w, http.StatusBadRequest, "You can't review a pull request that is not open.")
+			return
+		}
+
+		if participant == nil {
+			participant = &bitbucket.Participant{Type: ptr("participant"), Role: ptr("PARTICIPANT"), User: s.user}
+			pr.pullRequest.Participants = append(pr.pullRequest.Participants, participant)
+		}
+		participant.Approved = ptr(state == bitbucket.ParticipantStateApproved)
+		participant.State = ptr(state)
+		participant.ParticipatedOn = now()
+
+		writeJSON(w, http.StatusOK, participant)
+	case http.MethodDelete:
+		if participant == nil || participant.GetState() != state {
+			writeError(w, http.StatusNotFound, "You haven't reviewed this pull request.")
+			return
+		}
+		participant.Approved = ptr(false)
+		participant.State = nil
+
+		writeNoContent(w)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+// handlePullRequestTransition declines or merges an open pull request.
+// Merging moves the destination branch to a new merge commit.
+func (s *Server) handlePullRequestTransition(w http.ResponseWriter, r *http.Request, repo *repository, pr *pullRequest, state string) {
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	if pr.pullRequest.GetState() != "OPEN" {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("The pull request is %s.", pr.pullRequest.GetState()))
+		return
+	}
+
+	if state == "MERGED" {
+		req := new(bitbucket.MergePrRequest)
+		if !decode(w, r, req) {
+			return
+		}
+
+		hash := s.nextHash()
+		pr.pullRequest.MergeCommit = &bitbucket.PullRequestMergeCommit{Hash: ptr(hash)}
+		if ref := findRef(repo.branches, pr.pullRequest.GetDestination().GetBranch().GetName()); ref != nil {
+			ref.Target = &bitbucket.Commit{Type: ptr("commit"), Hash: ptr(hash), Date: now()}
+		}
+		if req.CloseSourceBranch != nil {
+			pr.pullRequest.CloseSourceBranch = req.CloseSourceBranch
+		}
+		if pr.pullRequest.GetCloseSourceBranch() {
+			name := pr.pullRequest.GetSource().GetBranch().GetName()
+			for i, ref := range repo.branches {
+				if ref.GetName() == name {
+					repo.branches = append(repo.branches[:i], repo.branches[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+
+	pr.pullRequest.State = ptr(state)
+	pr.pullRequest.ClosedBy = s.user
+	pr.pullRequest.UpdatedOn = timestamp()
+
+	writeJSON(w, http.StatusOK, pr.pullRequest)
+}
+
+func (s *Server) routePullRequestComments(w http.ResponseWriter, r *http.Request, repo *repository, pr *pullRequest, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writePage(w, r, s.pagelen, pr.comments)
+		case http.MethodPost:
+			req := new(bitbucket.PRCommentRequest)
+			if !decode(w, r, req) {
+				return
+			}
+
+			if req.GetContent().GetRaw() == "" {
+				writeError(w, http.StatusBadRequest, "content.raw is required")
+				return
+			}
+
+			repo.nextCommentID++
+			comment := &bitbucket.PRComment{
+				Comment: bitbucket.Comment{
+					Type:      ptr("pullrequest_comment"),
+					ID:        ptr(repo.nextCommentID),
+					Content:   &bitbucket.Content{Raw: req.Content.Raw, Markup: ptr("markdown")},
+					User:      s.user,
+					CreatedOn: now(),
+					UpdatedOn: now(),
+				},
+				PullRequest: &bitbucket.PullRequest{Type: ptr("pullrequest"), ID: pr.pullRequest.ID, Title: pr.pullRequest.Title},
+				Deleted:     ptr(false),
+				Inline:      req.Inline,
+				Parent:      req.Parent,
+				Pending:     ptr(req.GetPending()),
+			}
+			pr.comments = append(pr.comments, comment)
+			pr.pullRequest.CommentCount = ptr(int64(len(pr.comments)))
+
+			writeJSON(w, http.StatusCreated, comment)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	id, ok := parseID(w, segments[0])
+	if !ok {
+		return
+	}
+
+	var comment *bitbucket.PRComment
+	for _, c := range pr.comments {
+		if c.GetID() == id {
+			comment = c
+		}
+	}
+	if comment == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Comment %d not found", id))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, comment)
+	case http.MethodPut:
+		req := new(bitbucket.PRCommentRequest)
+		if !decode(w, r, req) {
+			return
+		}
+
+		if req.Content != nil && req.Content.Raw != nil {
+			comment.Content.Raw = req.Content.Raw
+		}
+		comment.UpdatedOn = now()
+
+		writeJSON(w, http.StatusOK, comment)
+	case http.MethodDelete:
+		// Bitbucket keeps deleted comments and marks them as such.
+		comment.Deleted = ptr(true)
+
+		writeNoContent(w)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
@@ -0,0 +1,276 @@
+package bbtest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/davidji99/bitbucket-go/bitbucket"
+)
+
+// repository holds the state of a single repository.
+type repository struct {
+	owner, slug string
+
+	repository    *bitbucket.Repository
+	branches      []*bitbucket.Ref
+	tags          []*bitbucket.Ref
+	pullRequests  []*pullRequest
+	issues        []*issue
+	statuses      map[string][]*bitbucket.CommitStatus
+	hooks         []*bitbucket.RepositoryHook
+	nextPRID      int64
+	nextIssueID   int64
+	nextCommentID int64
+}
+
+func (s *Server) findRepository(owner, slug string) *repository {
+	for _, repo := range s.repos {
+		if strings.EqualFold(repo.owner, owner) && strings.EqualFold(repo.slug, slug) {
+			return repo
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) createRepository(owner, slug string, req *bitbucket.RepositoryRequest) *repository {
+	name := req.GetName()
+	if name == "" {
+		name = slug
+	}
+
+	scm := req.GetSCM()
+	if scm == "" {
+		scm = "git"
+	}
+
+	repo := &repository{
+		owner: owner,
+		slug:  slug,
+		repository: &bitbucket.Repository{
+			Type:        ptr("repository"),
+			UUID:        ptr(s.nextUUID()),
+			Name:        ptr(name),
+			Slug:        ptr(slug),
+			FullName:    ptr(owner + "/" + slug),
+			SCM:         ptr(scm),
+			Description: ptr(req.GetDescription()),
+			ForkPolicy:  req.ForkPolicy,
+			HasIssues:   req.HasIssues,
+			HasWiki:     req.HasWiki,
+			IsPrivate:   ptr(true),
+			Owner:       &bitbucket.User{Username: ptr(owner), Type: ptr("team")},
+			MainBranch:  &bitbucket.RepositoryMainBranch{Type: ptr("branch"), Name: ptr("main")},
+			CreatedOn:   now(),
+			UpdatedOn:   now(),
+		},
+		statuses: make(map[string][]*bitbucket.CommitStatus),
+	}
+	repo.branches = append(repo.branches, newRef("branch", "main", s.nextHash()))
+
+	s.repos = append(s.repos, repo)
+
+	return repo
+}
+
+func newRef(refType, name, hash string) *bitbucket.Ref {
+	return &bitbucket.Ref{
+		Type:   ptr(refType),
+		Name:   ptr(name),
+		Target: &bitbucket.Commit{Type: ptr("commit"), Hash: ptr(hash), Date: now()},
+	}
+}
+
+// handleRepositories lists all repositories, or those of owner when it is set.
+func (s *Server) handleRepositories(w http.ResponseWriter, r *http.Request, owner string) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	values := make([]*bitbucket.Repository, 0)
+	for _, repo := range s.repos {
+		if owner == "" || strings.EqualFold(repo.owner, owner) {
+			values = append(values, repo.repository)
+		}
+	}
+
+	writePage(w, r, s.pagelen, values)
+}
+
+func (s *Server) handleRepository(w http.ResponseWriter, r *http.Request, owner, slug string) {
+	repo := s.findRepository(owner, slug)
+
+	if r.Method == http.MethodPost {
+		if repo != nil {
+			writeError(w, http.StatusBadRequest, "Repository with this Slug and Owner already exists.")
+			return
+		}
+
+		req := new(bitbucket.RepositoryRequest)
+		if !decode(w, r, req) {
+			return
+		}
+
+		writeJSON(w, http.StatusOK, s.createRepository(owner, slug, req).repository)
+		return
+	}
+
+	if repo == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Repository %s/%s not found", owner, slug))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, repo.repository)
+	case http.MethodPut:
+		req := new(bitbucket.RepositoryRequest)
+		if !decode(w, r, req) {
+			return
+		}
+
+		if req.Name != nil {
+			repo.repository.Name = req.Name
+		}
+		if req.Description != nil {
+			repo.repository.Description = req.Description
+		}
+		if req.ForkPolicy != nil {
+			repo.repository.ForkPolicy = req.ForkPolicy
+		}
+		if req.HasIssues != nil {
+			repo.repository.HasIssues = req.HasIssues
+		}
+		if req.HasWiki != nil {
+			repo.repository.HasWiki = req.HasWiki
+		}
+		repo.repository.UpdatedOn = now()
+
+		writeJSON(w, http.StatusOK, repo.repository)
+	case http.MethodDelete:
+		for i, candidate := range s.repos {
+			if candidate == repo {
+				s.repos = append(s.repos[:i], s.repos[i+1:]...)
+				break
+			}
+		}
+
+		writeNoContent(w)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) routeRefs(w http.ResponseWriter, r *http.Request, repo *repository, segments []string) {
+	if len(segments) == 0 {
+		if r.Method != http.MethodGet {
+			writeMethodNotAllowed(w)
+			return
+		}
+
+		writePage(w, r, s.pagelen, append(append([]*bitbucket.Ref{}, repo.branches...), repo.tags...))
+		return
+	}
+
+	var refs *[]*bitbucket.Ref
+	var refType string
+	switch segments[0] {
+	case "branches":
+		refs, refType = &repo.branches, "branch"
+	case "tags":
+		refs, refType = &repo.tags, "tag"
+	default:
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	// Ref names may contain slashes, such as "feature/login".
+	if len(segments) > 1 {
+		s.handleRef(w, r, refs, strings.Join(segments[1:], "/"))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writePage(w, r, s.pagelen, *refs)
+	case http.MethodPost:
+		req := new(bitbucket.RefRequest)
+		if !decode(w, r, req) {
+			return
+		}
+
+		if req.GetName() == "" {
+			writeError(w, http.StatusBadRequest, "name is required")
+			return
+		}
+		if findRef(*refs, req.GetName()) != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("%s \"%s\" already exists", refType, req.GetName()))
+			return
+		}
+
+		var hash string
+		if req.Target.Hash != nil {
+			hash = repo.resolve(*req.Target.Hash)
+		}
+		if hash == "" {
+			writeError(w, http.StatusBadRequest, "target.hash does not point at a known commit")
+			return
+		}
+
+		ref := newRef(refType, req.GetName(), hash)
+		*refs = append(*refs, ref)
+
+		writeJSON(w, http.StatusCreated, ref)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) handleRef(w http.ResponseWriter, r *http.Request, refs *[]*bitbucket.Ref, name string) {
+	ref := findRef(*refs, name)
+	if ref == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s not found", name))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, ref)
+	case http.MethodDelete:
+		for i, candidate := range *refs {
+			if candidate == ref {
+				*refs = append((*refs)[:i], (*refs)[i+1:]...)
+				break
+			}
+		}
+
+		writeNoContent(w)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func findRef(refs []*bitbucket.Ref, name string) *bitbucket.Ref {
+	for _, ref := range refs {
+		if ref.GetName() == name {
+			return ref
+		}
+	}
+
+	return nil
+}
+
+// resolve returns the commit hash a branch or tag name points at.
+// Any other non-empty value is taken to be a commit hash already.
+func (repo *repository) resolve(nameOrHash string) string {
+	if ref := findRef(repo.branches, nameOrHash); ref != nil {
+		return ref.GetTarget().GetHash()
+	}
+	if ref := findRef(repo.tags, nameOrHash); ref != nil {
+		return ref.GetTarget().GetHash()
+	}
+
+	return nameOrHash
+}
@@ -0,0 +1,116 @@
+package bbtest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// maxPagelen is the largest page size Bitbucket accepts.
+const maxPagelen = 100
+
+// page is a paginated collection, encoded like bitbucket.PaginationInfo followed by its values.
+type page[T any] struct {
+	Page     int    `json:"page"`
+	Pagelen  int    `json:"pagelen"`
+	Size     int    `json:"size"`
+	Next     string `json:"next,omitempty"`
+	Previous string `json:"previous,omitempty"`
+	Values   []T    `json:"values"`
+}
+
+// writePage writes the page of values selected by the page and pagelen query parameters of r,
+// with next and previous links to the neighbouring pages.
+func writePage[T any](w http.ResponseWriter, r *http.Request, defaultPagelen int, values []T) {
+	query := r.URL.Query()
+
+	number, err := strconv.Atoi(query.Get("page"))
+	if err != nil || number < 1 {
+		number = 1
+	}
+
+	pagelen, err := strconv.Atoi(query.Get("pagelen"))
+	if err != nil || pagelen < 1 {
+		pagelen = defaultPagelen
+	}
+	if pagelen > maxPagelen {
+		pagelen = maxPagelen
+	}
+
+	result := page[T]{Page: number, Pagelen: pagelen, Size: len(values), Values: []T{}}
+
+	start := (number - 1) * pagelen
+	if start < len(values) {
+		end := start + pagelen
+		if end > len(values) {
+			end = len(values)
+		}
+		result.Values = values[start:end]
+
+		if end < len(values) {
+			result.Next = pageURL(r, number+1)
+		}
+	}
+
+	if number > 1 {
+		result.Previous = pageURL(r, number-1)
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func pageURL(r *http.Request, number int) string {
+	u := *r.URL
+	u.Scheme = "http"
+	u.Host = r.Host
+
+	query := u.Query()
+	query.Set("page", strconv.Itoa(number))
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error body in the format Bitbucket uses, which the client decodes into a *bitbucket.ErrorResponse.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"type":  "error",
+		"error": map[string]string{"message": message},
+	})
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+}
+
+func writeNoContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decode reads the JSON body of r into v, writing a 400 error and returning false when it is invalid.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && err != io.EOF {
+		writeError(w, http.StatusBadRequest, "Invalid JSON body: "+err.Error())
+		return false
+	}
+
+	return true
+}
+
+// parseID parses the numeric id in a path segment, writing a 404 error and returning false when it is not a number.
+func parseID(w http.ResponseWriter, segment string) (int64, bool) {
+	id, err := strconv.ParseInt(segment, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return 0, false
+	}
+
+	return id, true
+}
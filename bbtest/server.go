@@ -0,0 +1,189 @@
+// Package bbtest provides an in-memory fake of the Bitbucket API for tests.
+//
+// A Server keeps repositories, branches and tags, pull requests and their comments, issues and their comments,
+// commit statuses and webhooks in memory, and serves them with the same paths and paginated collections
+// as Bitbucket. Point a client at it with the BaseURL option, or use Server.Client:
+//
+//	server := bbtest.NewServer()
+//	defer server.Close()
+//
+//	server.AddRepository("owner", "repo")
+//	client, _ := server.Client()
+//	repo, _, _ := client.Repositories.Get(ctx, "owner", "repo")
+package bbtest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/davidji99/bitbucket-go/bitbucket"
+)
+
+// DefaultPagelen is the number of values a Server returns per page when a request has no pagelen parameter.
+const DefaultPagelen = 10
+
+// Server is a stateful fake Bitbucket API served over HTTP.
+//
+// All requests are accepted regardless of their credentials and are made as the user returned by User.
+type Server struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	pagelen int
+	user    *bitbucket.User
+	repos   []*repository
+	counter int64
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		pagelen: DefaultPagelen,
+		user: &bitbucket.User{
+			Username:    ptr("bbtest"),
+			DisplayName: ptr("bbtest"),
+			UUID:        ptr("{00000000-0000-0000-0000-000000000000}"),
+			AccountID:   ptr("bbtest"),
+			Type:        ptr("user"),
+		},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Client returns a client configured to talk to the Server.
+func (s *Server) Client(opts ...bitbucket.Option) (*bitbucket.Client, error) {
+	return bitbucket.New("bbtest", "bbtest", append([]bitbucket.Option{bitbucket.BaseURL(s.URL)}, opts...)...)
+}
+
+// SetPagelen sets the number of values returned per page when a request has no pagelen parameter.
+func (s *Server) SetPagelen(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pagelen = n
+}
+
+// User returns the user that authors all resources created through the Server.
+func (s *Server) User() *bitbucket.User {
+	return s.user
+}
+
+// AddRepository creates a repository with a "main" branch pointing at an initial commit.
+func (s *Server) AddRepository(owner, slug string) *bitbucket.Repository {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.createRepository(owner, slug, &bitbucket.RepositoryRequest{}).repository
+}
+
+// AddBranch creates a branch in a repository pointing at a new commit and returns the commit hash.
+// It panics if the repository does not exist.
+func (s *Server) AddBranch(owner, slug, name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo := s.findRepository(owner, slug)
+	if repo == nil {
+		panic(fmt.Sprintf("bbtest: repository %s/%s does not exist", owner, slug))
+	}
+
+	hash := s.nextHash()
+	repo.branches = append(repo.branches, newRef("branch", name, hash))
+
+	return hash
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case len(segments) == 1 && segments[0] == "user":
+		s.handleUser(w, r)
+	case segments[0] == "repositories":
+		s.routeRepositories(w, r, segments[1:])
+	default:
+		writeError(w, http.StatusNotFound, "Resource not found")
+	}
+}
+
+func (s *Server) handleUser(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.user)
+}
+
+func (s *Server) routeRepositories(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch len(segments) {
+	case 0:
+		s.handleRepositories(w, r, "")
+		return
+	case 1:
+		s.handleRepositories(w, r, segments[0])
+		return
+	case 2:
+		s.handleRepository(w, r, segments[0], segments[1])
+		return
+	}
+
+	repo := s.findRepository(segments[0], segments[1])
+	if repo == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Repository %s/%s not found", segments[0], segments[1]))
+		return
+	}
+
+	rest := segments[2:]
+	switch rest[0] {
+	case "refs":
+		s.routeRefs(w, r, repo, rest[1:])
+	case "pullrequests":
+		s.routePullRequests(w, r, repo, rest[1:])
+	case "issues":
+		s.routeIssues(w, r, repo, rest[1:])
+	case "commit":
+		s.routeCommitStatuses(w, r, repo, rest[1:])
+	case "hooks":
+		s.routeHooks(w, r, repo, rest[1:])
+	default:
+		writeError(w, http.StatusNotFound, "Resource not found")
+	}
+}
+
+// nextID returns a new identifier, unique within the Server.
+func (s *Server) nextID() int64 {
+	s.counter++
+	return s.counter
+}
+
+func (s *Server) nextUUID() string {
+	return fmt.Sprintf("{00000000-0000-4000-8000-%012d}", s.nextID())
+}
+
+func (s *Server) nextHash() string {
+	return fmt.Sprintf("%040x", s.nextID())
+}
+
+func now() *time.Time {
+	t := time.Now().UTC()
+	return &t
+}
+
+// timestamp returns the current time formatted like the string timestamps of the API.
+func timestamp() *string {
+	return ptr(time.Now().UTC().Format(time.RFC3339Nano))
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
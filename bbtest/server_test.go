@@ -0,0 +1,133 @@
+package bbtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/davidji99/bitbucket-go/bitbucket"
+	"github.com/davidji99/simpleresty"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T) (*Server, *bitbucket.Client) {
+	server := NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := server.Client()
+	assert.Nil(t, err)
+
+	return server, client
+}
+
+func TestServer_PullRequestLifecycle(t *testing.T) {
+	server, client := newTestClient(t)
+	ctx := context.Background()
+
+	server.AddRepository("owner", "repo")
+	featureHash := server.AddBranch("owner", "repo", "feature/login")
+
+	title, feature, status, key := "Add login", "feature/login", "SUCCESSFUL", "ci"
+	pr, _, err := client.PullRequests.Create(ctx, "owner", "repo", &bitbucket.PRRequest{
+		Title:  &title,
+		Source: &bitbucket.PRRequestSourceOpts{Branch: &bitbucket.Branch{Name: &feature}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), pr.GetID())
+	assert.Equal(t, "main", pr.GetDestination().GetBranch().GetName())
+	assert.Equal(t, featureHash, pr.GetSource().GetCommit().GetHash())
+
+	_, _, err = client.Commit.CreateStatus(ctx, "owner", "repo", featureHash, &bitbucket.CommitStatusRequest{State: &status, Key: &key})
+	assert.Nil(t, err)
+
+	statuses, _, err := client.PullRequests.ListStatuses(ctx, "owner", "repo", pr.GetID())
+	assert.Nil(t, err)
+	assert.Len(t, statuses.Values, 1)
+	assert.Equal(t, "SUCCESSFUL", statuses.Values[0].GetState())
+
+	raw := "Looks good"
+	comment, _, err := client.PullRequests.CreateComment(ctx, "owner", "repo", pr.GetID(), &bitbucket.PRCommentRequest{Content: &bitbucket.Content{Raw: &raw}})
+	assert.Nil(t, err)
+	assert.Equal(t, "Looks good", comment.GetContent().GetRaw())
+
+	participant, _, err := client.PullRequests.RequestChanges(ctx, "owner", "repo", pr.GetID())
+	assert.Nil(t, err)
+	assert.Equal(t, bitbucket.ParticipantStateChangesRequested, participant.GetState())
+
+	_, err = client.PullRequests.RemoveChangeRequest(ctx, "owner", "repo", pr.GetID())
+	assert.Nil(t, err)
+
+	participant, _, err = client.PullRequests.Approve(ctx, "owner", "repo", pr.GetID())
+	assert.Nil(t, err)
+	assert.True(t, participant.GetApproved())
+	assert.Equal(t, bitbucket.ParticipantStateApproved, participant.GetState())
+
+	merged, _, err := client.PullRequests.MergePR(ctx, "owner", "repo", pr.GetID(), &bitbucket.MergePrRequest{Type: "pullrequest"})
+	assert.Nil(t, err)
+	assert.Equal(t, "MERGED", merged.GetState())
+
+	main, _, err := client.Refs.GetBranch(ctx, "owner", "repo", "main")
+	assert.Nil(t, err)
+	assert.Equal(t, merged.GetMergeCommit().GetHash(), main.GetTarget().GetHash())
+
+	open, _, err := client.PullRequests.List(ctx, "owner", "repo")
+	assert.Nil(t, err)
+	assert.Empty(t, open.Values)
+}
+
+func TestServer_Pagination(t *testing.T) {
+	server, client := newTestClient(t)
+	ctx := context.Background()
+
+	server.SetPagelen(2)
+	server.AddRepository("owner", "repo")
+	for i := 0; i < 4; i++ {
+		server.AddBranch("owner", "repo", string(rune('a'+i)))
+	}
+
+	first, _, err := client.Refs.ListBranches(ctx, "owner", "repo")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), first.GetSize())
+	assert.Len(t, first.Values, 2)
+	assert.NotEmpty(t, first.GetNext())
+
+	pager := bitbucket.NewPager[*bitbucket.Ref](client, func(ctx context.Context, opts ...interface{}) (*bitbucket.Refs, *simpleresty.Response, error) {
+		return client.Refs.ListBranches(ctx, "owner", "repo", opts...)
+	})
+	branches, err := pager.All(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, branches, 5)
+	assert.Equal(t, "main", branches[0].GetName())
+	assert.Equal(t, "d", branches[4].GetName())
+}
+
+func TestServer_IssuesHooksAndErrors(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	name := "repo"
+	_, _, err := client.Repositories.Create(ctx, "owner", &bitbucket.RepositoryRequest{Name: &name})
+	assert.Nil(t, err)
+
+	title := "Crash on start"
+	issue, _, err := client.Issues.Create(ctx, "owner", "repo", &bitbucket.IssueRequest{Title: &title})
+	assert.Nil(t, err)
+	assert.Equal(t, "new", issue.GetState())
+
+	_, err = client.Issues.Delete(ctx, "owner", "repo", issue.GetID())
+	assert.Nil(t, err)
+
+	_, _, err = client.Issues.Get(ctx, "owner", "repo", issue.GetID())
+	assert.True(t, bitbucket.IsNotFound(err))
+
+	url, event := "https://example.com/hook", "repo:push"
+	hook, _, err := client.Repositories.CreateHook(ctx, "owner", "repo", &bitbucket.RepositoryHookRequest{URL: &url, Events: []*string{&event}})
+	assert.Nil(t, err)
+
+	fetched, _, err := client.Repositories.GetHook(ctx, "owner", "repo", hook.GetUUID())
+	assert.Nil(t, err)
+	assert.Equal(t, "repository", fetched.GetSubjectType())
+	assert.Equal(t, "https://example.com/hook", fetched.GetURL())
+
+	_, _, err = client.Repositories.Get(ctx, "owner", "missing")
+	assert.True(t, bitbucket.IsNotFound(err))
+}
@@ -0,0 +1,117 @@
+package bbtest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/davidji99/bitbucket-go/bitbucket"
+)
+
+func (repo *repository) statusesOf(hash string) []*bitbucket.CommitStatus {
+	statuses := repo.statuses[hash]
+	if statuses == nil {
+		return make([]*bitbucket.CommitStatus, 0)
+	}
+
+	return statuses
+}
+
+// routeCommitStatuses serves the build statuses under /commit/{sha}/statuses.
+func (s *Server) routeCommitStatuses(w http.ResponseWriter, r *http.Request, repo *repository, segments []string) {
+	if len(segments) < 2 || segments[1] != "statuses" || len(segments) > 4 || (len(segments) > 2 && segments[2] != "build") {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	hash := repo.resolve(segments[0])
+
+	switch len(segments) {
+	case 2:
+		if r.Method != http.MethodGet {
+			writeMethodNotAllowed(w)
+			return
+		}
+
+		writePage(w, r, s.pagelen, repo.statusesOf(hash))
+	case 3:
+		if r.Method != http.MethodPost {
+			writeMethodNotAllowed(w)
+			return
+		}
+
+		req := new(bitbucket.CommitStatusRequest)
+		if !decode(w, r, req) {
+			return
+		}
+
+		if req.GetKey() == "" || req.GetState() == "" {
+			writeError(w, http.StatusBadRequest, "key and state are required")
+			return
+		}
+
+		// Posting a status with an existing key replaces it, as Bitbucket does.
+		status := repo.findStatus(hash, req.GetKey())
+		if status == nil {
+			status = &bitbucket.CommitStatus{
+				Key:       req.Key,
+				UUID:      ptr(s.nextUUID()),
+				CreatedOn: now(),
+			}
+			repo.statuses[hash] = append(repo.statuses[hash], status)
+		}
+		applyStatusRequest(status, req)
+
+		writeJSON(w, http.StatusCreated, status)
+	case 4:
+		status := repo.findStatus(hash, segments[3])
+		if status == nil {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Build status %s not found", segments[3]))
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, status)
+		case http.MethodPut:
+			req := new(bitbucket.CommitStatusRequest)
+			if !decode(w, r, req) {
+				return
+			}
+
+			applyStatusRequest(status, req)
+
+			writeJSON(w, http.StatusOK, status)
+		default:
+			writeMethodNotAllowed(w)
+		}
+	}
+}
+
+func (repo *repository) findStatus(hash, key string) *bitbucket.CommitStatus {
+	for _, status := range repo.statuses[hash] {
+		if status.GetKey() == key {
+			return status
+		}
+	}
+
+	return nil
+}
+
+func applyStatusRequest(status *bitbucket.CommitStatus, req *bitbucket.CommitStatusRequest) {
+	if req.State != nil {
+		status.State = req.State
+	}
+	if req.Name != nil {
+		status.Name = req.Name
+	}
+	if req.URL != nil {
+		status.URL = req.URL
+	}
+	if req.Description != nil {
+		status.Description = req.Description
+	}
+	if req.Refname != nil {
+		status.Refname = req.Refname
+	}
+	status.UpdatedOn = now()
+}
@@ -0,0 +1,109 @@
+package bitbucket
+
+import (
+	"encoding/base64"
+	"net/http"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// Authenticator signs the requests a Client sends to the Bitbucket API.
+//
+// The package provides AppPassword, AccessToken, OAuthAuthenticator and Anonymous. Pass one to NewWithAuth.
+type Authenticator interface {
+	// Authenticate adds credentials, usually the Authorization header, to req. It is called before every request,
+	// including retries, and the request is not sent if it returns an error.
+	Authenticate(req *http.Request) error
+}
+
+// AppPassword authenticates with a username and an app password using basic authentication.
+type AppPassword struct {
+	Username string
+	Password string
+}
+
+// Authenticate sets the basic authentication header of req.
+func (a *AppPassword) Authenticate(req *http.Request) error {
+	req.SetBasicAuth(a.Username, a.Password)
+	return nil
+}
+
+func (a *AppPassword) secrets() []string {
+	return []string{a.Password, base64.StdEncoding.EncodeToString([]byte(a.Username + ":" + a.Password))}
+}
+
+// AccessToken authenticates with a bearer token, such as a repository, project or workspace access token.
+type AccessToken struct {
+	Token string
+}
+
+// RepositoryAccessToken returns an Authenticator for a repository access token.
+func RepositoryAccessToken(token string) *AccessToken {
+	return &AccessToken{Token: token}
+}
+
+// ProjectAccessToken returns an Authenticator for a project access token.
+func ProjectAccessToken(token string) *AccessToken {
+	return &AccessToken{Token: token}
+}
+
+// WorkspaceAccessToken returns an Authenticator for a workspace access token.
+func WorkspaceAccessToken(token string) *AccessToken {
+	return &AccessToken{Token: token}
+}
+
+// Authenticate sets the bearer authorization header of req.
+func (a *AccessToken) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+	return nil
+}
+
+func (a *AccessToken) secrets() []string {
+	return []string{a.Token}
+}
+
+// OAuthAuthenticator authenticates with OAuth tokens from Source. The token is requested before every request,
+// so Source should cache and refresh it, as the token sources of the golang.org/x/oauth2 package do.
+type OAuthAuthenticator struct {
+	Source oauth2.TokenSource
+
+	mu    sync.Mutex
+	token *oauth2.Token
+}
+
+// Authenticate sets the authorization header of req from the current token of Source.
+func (a *OAuthAuthenticator) Authenticate(req *http.Request) error {
+	token, err := a.Source.Token()
+	if err != nil {
+		return err
+	}
+
+	token.SetAuthHeader(req)
+
+	a.mu.Lock()
+	a.token = token
+	a.mu.Unlock()
+
+	return nil
+}
+
+// secrets returns the token last used to authenticate a request, if any.
+func (a *OAuthAuthenticator) secrets() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token == nil {
+		return nil
+	}
+
+	return []string{a.token.AccessToken, a.token.RefreshToken}
+}
+
+// Anonymous sends requests without credentials, which gives access to public resources only.
+type Anonymous struct{}
+
+// Authenticate leaves req unchanged.
+func (Anonymous) Authenticate(req *http.Request) error {
+	return nil
+}
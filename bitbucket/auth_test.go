@@ -0,0 +1,77 @@
+package bitbucket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+func authHeader(t *testing.T, newClient func(baseURL string) (*Client, error)) string {
+	t.Helper()
+
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"slug":"repo"}`))
+	}))
+	defer server.Close()
+
+	client, err := newClient(server.URL)
+	assert.Nil(t, err)
+
+	_, _, getErr := client.Repositories.Get(context.Background(), "owner", "repo")
+	assert.Nil(t, getErr)
+
+	return header
+}
+
+func TestNew_AppPassword(t *testing.T) {
+	header := authHeader(t, func(baseURL string) (*Client, error) {
+		return New("user", "password", BaseURL(baseURL))
+	})
+	assert.Equal(t, "Basic dXNlcjpwYXNzd29yZA==", header)
+}
+
+func TestNew_AuthOptionTakesPrecedence(t *testing.T) {
+	header := authHeader(t, func(baseURL string) (*Client, error) {
+		return New("user", "password", BaseURL(baseURL), OAuthBearerToken("token"))
+	})
+	assert.Equal(t, "Bearer token", header)
+
+	header = authHeader(t, func(baseURL string) (*Client, error) {
+		return New("", "", BaseURL(baseURL), OAuthToken(oauth2.Token{AccessToken: "oauth-token"}))
+	})
+	assert.Equal(t, "Bearer oauth-token", header)
+}
+
+func TestNew_Anonymous(t *testing.T) {
+	header := authHeader(t, func(baseURL string) (*Client, error) {
+		return New("", "", BaseURL(baseURL))
+	})
+	assert.Equal(t, "", header)
+}
+
+func TestNewWithAuth(t *testing.T) {
+	for name, auth := range map[string]Authenticator{
+		"repository": RepositoryAccessToken("repo-token"),
+		"project":    ProjectAccessToken("repo-token"),
+		"workspace":  WorkspaceAccessToken("repo-token"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			header := authHeader(t, func(baseURL string) (*Client, error) {
+				return NewWithAuth(auth, BaseURL(baseURL), OAuthBearerToken("ignored"))
+			})
+			assert.Equal(t, "Bearer repo-token", header)
+		})
+	}
+
+	header := authHeader(t, func(baseURL string) (*Client, error) {
+		return NewWithAuth(Anonymous{}, BaseURL(baseURL))
+	})
+	assert.Equal(t, "", header)
+}
@@ -253,6 +253,14 @@ func (b *BranchRestriction) GetBranchMatchKind() string {
 	return *b.BranchMatchKind
 }
 
+// GetBranchType returns the BranchType field if it's non-nil, zero value otherwise.
+func (b *BranchRestriction) GetBranchType() string {
+	if b == nil || b.BranchType == nil {
+		return ""
+	}
+	return *b.BranchType
+}
+
 // GetID returns the ID field if it's non-nil, zero value otherwise.
 func (b *BranchRestriction) GetID() int64 {
 	if b == nil || b.ID == nil {
@@ -381,6 +389,22 @@ func (c *CCLinks) GetSelf() *Link {
 	return c.Self
 }
 
+// GetCommit returns the Commit field.
+func (c *CodeFile) GetCommit() *Commit {
+	if c == nil {
+		return nil
+	}
+	return c.Commit
+}
+
+// GetEscapedPath returns the EscapedPath field if it's non-nil, zero value otherwise.
+func (c *CodeFile) GetEscapedPath() string {
+	if c == nil || c.EscapedPath == nil {
+		return ""
+	}
+	return *c.EscapedPath
+}
+
 // GetLinks returns the Links field.
 func (c *CodeFile) GetLinks() *SearchCodeFileLinks {
 	if c == nil {
@@ -1121,780 +1145,840 @@ func (d *DeployKeys) HasValues() bool {
 	return true
 }
 
-// GetLinesAdded returns the LinesAdded field if it's non-nil, zero value otherwise.
-func (d *Diff) GetLinesAdded() int64 {
-	if d == nil || d.LinesAdded == nil {
-		return 0
+// GetDeployable returns the Deployable field.
+func (d *Deployment) GetDeployable() *DeploymentDeployable {
+	if d == nil {
+		return nil
 	}
-	return *d.LinesAdded
+	return d.Deployable
 }
 
-// GetLinesRemoved returns the LinesRemoved field if it's non-nil, zero value otherwise.
-func (d *Diff) GetLinesRemoved() int64 {
-	if d == nil || d.LinesRemoved == nil {
+// GetEnvironment returns the Environment field.
+func (d *Deployment) GetEnvironment() *Environment {
+	if d == nil {
+		return nil
+	}
+	return d.Environment
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetKey() string {
+	if d == nil || d.Key == nil {
+		return ""
+	}
+	return *d.Key
+}
+
+// GetLastUpdate returns the LastUpdate field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetLastUpdate() time.Time {
+	if d == nil || d.LastUpdate == nil {
+		return time.Time{}
+	}
+	return *d.LastUpdate
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetNumber() int64 {
+	if d == nil || d.Number == nil {
 		return 0
 	}
-	return *d.LinesRemoved
+	return *d.Number
 }
 
-// GetNew returns the New field.
-func (d *Diff) GetNew() *CodeFile {
+// GetRelease returns the Release field.
+func (d *Deployment) GetRelease() *DeploymentRelease {
 	if d == nil {
 		return nil
 	}
-	return d.New
+	return d.Release
 }
 
-// GetOld returns the Old field.
-func (d *Diff) GetOld() *CodeFile {
+// GetState returns the State field.
+func (d *Deployment) GetState() *DeploymentState {
 	if d == nil {
 		return nil
 	}
-	return d.Old
+	return d.State
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (d *Diff) GetStatus() string {
-	if d == nil || d.Status == nil {
-		return ""
+// GetStep returns the Step field.
+func (d *Deployment) GetStep() *PipelineStepReference {
+	if d == nil {
+		return nil
 	}
-	return *d.Status
+	return d.Step
 }
 
 // GetType returns the Type field if it's non-nil, zero value otherwise.
-func (d *Diff) GetType() string {
+func (d *Deployment) GetType() string {
 	if d == nil || d.Type == nil {
 		return ""
 	}
 	return *d.Type
 }
 
-// GetBinary returns the Binary field if it's non-nil, zero value otherwise.
-func (d *DiffGetOpts) GetBinary() string {
-	if d == nil || d.Binary == nil {
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetUUID() string {
+	if d == nil || d.UUID == nil {
 		return ""
 	}
-	return *d.Binary
+	return *d.UUID
 }
 
-// GetContext returns the Context field if it's non-nil, zero value otherwise.
-func (d *DiffGetOpts) GetContext() int {
-	if d == nil || d.Context == nil {
-		return 0
+// GetCommit returns the Commit field.
+func (d *DeploymentDeployable) GetCommit() *Commit {
+	if d == nil {
+		return nil
 	}
-	return *d.Context
+	return d.Commit
 }
 
-// GetIgnoreWhitespace returns the IgnoreWhitespace field if it's non-nil, zero value otherwise.
-func (d *DiffGetOpts) GetIgnoreWhitespace() string {
-	if d == nil || d.IgnoreWhitespace == nil {
-		return ""
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (d *DeploymentDeployable) GetCreatedOn() time.Time {
+	if d == nil || d.CreatedOn == nil {
+		return time.Time{}
 	}
-	return *d.IgnoreWhitespace
+	return *d.CreatedOn
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (d *DiffGetOpts) GetPath() string {
-	if d == nil || d.Path == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DeploymentDeployable) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *d.Path
+	return *d.Name
 }
 
-// HasValues checks if Diffs has any Values.
-func (d *Diffs) HasValues() bool {
-	if d == nil || d.Values == nil {
-		return false
+// GetPipeline returns the Pipeline field.
+func (d *DeploymentDeployable) GetPipeline() *PipelineStepReference {
+	if d == nil {
+		return nil
 	}
+	return d.Pipeline
+}
 
-	if len(d.Values) == 0 {
-		return false
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (d *DeploymentDeployable) GetType() string {
+	if d == nil || d.Type == nil {
+		return ""
 	}
-	return true
+	return *d.Type
 }
 
-// HasValues checks if FileHistory has any Values.
-func (f *FileHistory) HasValues() bool {
-	if f == nil || f.Values == nil {
-		return false
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DeploymentDeployable) GetURL() string {
+	if d == nil || d.URL == nil {
+		return ""
 	}
+	return *d.URL
+}
 
-	if len(f.Values) == 0 {
-		return false
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (d *DeploymentDeployable) GetUUID() string {
+	if d == nil || d.UUID == nil {
+		return ""
 	}
-	return true
+	return *d.UUID
 }
 
-// GetHistory returns the History field.
-func (f *FileHistoryLinks) GetHistory() *Link {
-	if f == nil {
+// GetCommit returns the Commit field.
+func (d *DeploymentRelease) GetCommit() *Commit {
+	if d == nil {
 		return nil
 	}
-	return f.History
+	return d.Commit
 }
 
-// GetMeta returns the Meta field.
-func (f *FileHistoryLinks) GetMeta() *Link {
-	if f == nil {
-		return nil
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (d *DeploymentRelease) GetCreatedOn() time.Time {
+	if d == nil || d.CreatedOn == nil {
+		return time.Time{}
 	}
-	return f.Meta
+	return *d.CreatedOn
 }
 
-// GetSelf returns the Self field.
-func (f *FileHistoryLinks) GetSelf() *Link {
-	if f == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DeploymentRelease) GetName() string {
+	if d == nil || d.Name == nil {
+		return ""
+	}
+	return *d.Name
+}
+
+// GetPipeline returns the Pipeline field.
+func (d *DeploymentRelease) GetPipeline() *PipelineStepReference {
+	if d == nil {
 		return nil
 	}
-	return f.Self
+	return d.Pipeline
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (f *ForkRequest) GetDescription() string {
-	if f == nil || f.Description == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (d *DeploymentRelease) GetType() string {
+	if d == nil || d.Type == nil {
 		return ""
 	}
-	return *f.Description
+	return *d.Type
 }
 
-// GetForkPolicy returns the ForkPolicy field if it's non-nil, zero value otherwise.
-func (f *ForkRequest) GetForkPolicy() string {
-	if f == nil || f.ForkPolicy == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DeploymentRelease) GetURL() string {
+	if d == nil || d.URL == nil {
 		return ""
 	}
-	return *f.ForkPolicy
+	return *d.URL
 }
 
-// GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
-func (f *ForkRequest) GetHasIssues() string {
-	if f == nil || f.HasIssues == nil {
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (d *DeploymentRelease) GetUUID() string {
+	if d == nil || d.UUID == nil {
 		return ""
 	}
-	return *f.HasIssues
+	return *d.UUID
 }
 
-// GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
-func (f *ForkRequest) GetHasWiki() bool {
-	if f == nil || f.HasWiki == nil {
+// HasValues checks if Deployments has any Values.
+func (d *Deployments) HasValues() bool {
+	if d == nil || d.Values == nil {
 		return false
 	}
-	return *f.HasWiki
-}
 
-// GetIsPrivate returns the IsPrivate field if it's non-nil, zero value otherwise.
-func (f *ForkRequest) GetIsPrivate() bool {
-	if f == nil || f.IsPrivate == nil {
+	if len(d.Values) == 0 {
 		return false
 	}
-	return *f.IsPrivate
+	return true
 }
 
-// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
-func (f *ForkRequest) GetLanguage() string {
-	if f == nil || f.Language == nil {
-		return ""
+// GetCompletedOn returns the CompletedOn field if it's non-nil, zero value otherwise.
+func (d *DeploymentState) GetCompletedOn() time.Time {
+	if d == nil || d.CompletedOn == nil {
+		return time.Time{}
 	}
-	return *f.Language
+	return *d.CompletedOn
 }
 
-// GetMainBranch returns the MainBranch field.
-func (f *ForkRequest) GetMainBranch() *RepositoryMainBranch {
-	if f == nil {
+// GetDeployer returns the Deployer field.
+func (d *DeploymentState) GetDeployer() *User {
+	if d == nil {
 		return nil
 	}
-	return f.MainBranch
+	return d.Deployer
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (f *ForkRequest) GetName() string {
-	if f == nil || f.Name == nil {
+func (d *DeploymentState) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *f.Name
+	return *d.Name
 }
 
-// GetOwner returns the Owner field.
-func (f *ForkRequest) GetOwner() *User {
-	if f == nil {
-		return nil
+// GetStartedOn returns the StartedOn field if it's non-nil, zero value otherwise.
+func (d *DeploymentState) GetStartedOn() time.Time {
+	if d == nil || d.StartedOn == nil {
+		return time.Time{}
 	}
-	return f.Owner
+	return *d.StartedOn
 }
 
-// GetParent returns the Parent field.
-func (f *ForkRequest) GetParent() *Repository {
-	if f == nil {
+// GetStatus returns the Status field.
+func (d *DeploymentState) GetStatus() *DeploymentStateStatus {
+	if d == nil {
 		return nil
 	}
-	return f.Parent
+	return d.Status
 }
 
-// GetSCM returns the SCM field if it's non-nil, zero value otherwise.
-func (f *ForkRequest) GetSCM() string {
-	if f == nil || f.SCM == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (d *DeploymentState) GetType() string {
+	if d == nil || d.Type == nil {
 		return ""
 	}
-	return *f.SCM
+	return *d.Type
 }
 
-// GetCategory returns the Category field if it's non-nil, zero value otherwise.
-func (h *HookEvent) GetCategory() string {
-	if h == nil || h.Category == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DeploymentState) GetURL() string {
+	if d == nil || d.URL == nil {
 		return ""
 	}
-	return *h.Category
+	return *d.URL
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (h *HookEvent) GetDescription() string {
-	if h == nil || h.Description == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DeploymentStateStatus) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *h.Description
+	return *d.Name
 }
 
-// GetEvent returns the Event field if it's non-nil, zero value otherwise.
-func (h *HookEvent) GetEvent() string {
-	if h == nil || h.Event == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (d *DeploymentStateStatus) GetType() string {
+	if d == nil || d.Type == nil {
 		return ""
 	}
-	return *h.Event
+	return *d.Type
 }
 
-// GetLabel returns the Label field if it's non-nil, zero value otherwise.
-func (h *HookEvent) GetLabel() string {
-	if h == nil || h.Label == nil {
-		return ""
+// GetLinesAdded returns the LinesAdded field if it's non-nil, zero value otherwise.
+func (d *Diff) GetLinesAdded() int64 {
+	if d == nil || d.LinesAdded == nil {
+		return 0
 	}
-	return *h.Label
+	return *d.LinesAdded
 }
 
-// HasValues checks if HookEvents has any Values.
-func (h *HookEvents) HasValues() bool {
-	if h == nil || h.Values == nil {
-		return false
-	}
-
-	if len(h.Values) == 0 {
-		return false
+// GetLinesRemoved returns the LinesRemoved field if it's non-nil, zero value otherwise.
+func (d *Diff) GetLinesRemoved() int64 {
+	if d == nil || d.LinesRemoved == nil {
+		return 0
 	}
-	return true
+	return *d.LinesRemoved
 }
 
-// GetRepository returns the Repository field.
-func (h *HookEventTypes) GetRepository() *HookEventTypesLinks {
-	if h == nil {
+// GetNew returns the New field.
+func (d *Diff) GetNew() *CodeFile {
+	if d == nil {
 		return nil
 	}
-	return h.Repository
+	return d.New
 }
 
-// GetTeam returns the Team field.
-func (h *HookEventTypes) GetTeam() *HookEventTypesLinks {
-	if h == nil {
+// GetOld returns the Old field.
+func (d *Diff) GetOld() *CodeFile {
+	if d == nil {
 		return nil
 	}
-	return h.Team
+	return d.Old
 }
 
-// GetUser returns the User field.
-func (h *HookEventTypes) GetUser() *HookEventTypesLinks {
-	if h == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (d *Diff) GetType() string {
+	if d == nil || d.Type == nil {
+		return ""
 	}
-	return h.User
+	return *d.Type
 }
 
-// GetEvents returns the Events field.
-func (h *HookEventTypesLinks) GetEvents() *Link {
-	if h == nil {
-		return nil
+// GetBinary returns the Binary field if it's non-nil, zero value otherwise.
+func (d *DiffGetOpts) GetBinary() string {
+	if d == nil || d.Binary == nil {
+		return ""
 	}
-	return h.Events
+	return *d.Binary
 }
 
-// GetAssignee returns the Assignee field.
-func (i *Issue) GetAssignee() *User {
-	if i == nil {
-		return nil
+// GetContext returns the Context field if it's non-nil, zero value otherwise.
+func (d *DiffGetOpts) GetContext() int {
+	if d == nil || d.Context == nil {
+		return 0
 	}
-	return i.Assignee
+	return *d.Context
 }
 
-// GetComponent returns the Component field.
-func (i *Issue) GetComponent() *Component {
-	if i == nil {
-		return nil
+// GetIgnoreWhitespace returns the IgnoreWhitespace field if it's non-nil, zero value otherwise.
+func (d *DiffGetOpts) GetIgnoreWhitespace() string {
+	if d == nil || d.IgnoreWhitespace == nil {
+		return ""
 	}
-	return i.Component
+	return *d.IgnoreWhitespace
 }
 
-// GetContent returns the Content field.
-func (i *Issue) GetContent() *IssueContent {
-	if i == nil {
-		return nil
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (d *DiffGetOpts) GetPath() string {
+	if d == nil || d.Path == nil {
+		return ""
 	}
-	return i.Content
+	return *d.Path
 }
 
-// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
-func (i *Issue) GetCreatedOn() time.Time {
-	if i == nil || i.CreatedOn == nil {
-		return time.Time{}
+// HasValues checks if Diffs has any Values.
+func (d *Diffs) HasValues() bool {
+	if d == nil || d.Values == nil {
+		return false
 	}
-	return *i.CreatedOn
-}
 
-// GetEditedOn returns the EditedOn field if it's non-nil, zero value otherwise.
-func (i *Issue) GetEditedOn() time.Time {
-	if i == nil || i.EditedOn == nil {
-		return time.Time{}
+	if len(d.Values) == 0 {
+		return false
 	}
-	return *i.EditedOn
+	return true
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *Issue) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
+// GetIgnoreWhitespace returns the IgnoreWhitespace field if it's non-nil, zero value otherwise.
+func (d *DiffStatOpts) GetIgnoreWhitespace() bool {
+	if d == nil || d.IgnoreWhitespace == nil {
+		return false
 	}
-	return *i.ID
+	return *d.IgnoreWhitespace
 }
 
-// GetKind returns the Kind field if it's non-nil, zero value otherwise.
-func (i *Issue) GetKind() string {
-	if i == nil || i.Kind == nil {
-		return ""
+// GetMerge returns the Merge field if it's non-nil, zero value otherwise.
+func (d *DiffStatOpts) GetMerge() bool {
+	if d == nil || d.Merge == nil {
+		return false
 	}
-	return *i.Kind
+	return *d.Merge
 }
 
-// GetLinks returns the Links field.
-func (i *Issue) GetLinks() *IssueLinks {
-	if i == nil {
-		return nil
+// HasPath checks if DiffStatOpts has any Path.
+func (d *DiffStatOpts) HasPath() bool {
+	if d == nil || d.Path == nil {
+		return false
 	}
-	return i.Links
+
+	if len(d.Path) == 0 {
+		return false
+	}
+	return true
 }
 
-// GetMilestone returns the Milestone field.
-func (i *Issue) GetMilestone() *Milestone {
-	if i == nil {
-		return nil
+// GetRenames returns the Renames field if it's non-nil, zero value otherwise.
+func (d *DiffStatOpts) GetRenames() bool {
+	if d == nil || d.Renames == nil {
+		return false
 	}
-	return i.Milestone
+	return *d.Renames
 }
 
-// GetPriority returns the Priority field if it's non-nil, zero value otherwise.
-func (i *Issue) GetPriority() string {
-	if i == nil || i.Priority == nil {
-		return ""
+// GetDeploymentGateEnabled returns the DeploymentGateEnabled field if it's non-nil, zero value otherwise.
+func (e *Environment) GetDeploymentGateEnabled() bool {
+	if e == nil || e.DeploymentGateEnabled == nil {
+		return false
 	}
-	return *i.Priority
+	return *e.DeploymentGateEnabled
 }
 
-// GetReporter returns the Reporter field.
-func (i *Issue) GetReporter() *User {
-	if i == nil {
-		return nil
+// GetEnvironmentLockEnabled returns the EnvironmentLockEnabled field if it's non-nil, zero value otherwise.
+func (e *Environment) GetEnvironmentLockEnabled() bool {
+	if e == nil || e.EnvironmentLockEnabled == nil {
+		return false
 	}
-	return i.Reporter
+	return *e.EnvironmentLockEnabled
 }
 
-// GetRepository returns the Repository field.
-func (i *Issue) GetRepository() *Repository {
-	if i == nil {
+// GetEnvironmentType returns the EnvironmentType field.
+func (e *Environment) GetEnvironmentType() *EnvironmentType {
+	if e == nil {
 		return nil
 	}
-	return i.Repository
+	return e.EnvironmentType
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (i *Issue) GetState() string {
-	if i == nil || i.State == nil {
-		return ""
+// GetHidden returns the Hidden field if it's non-nil, zero value otherwise.
+func (e *Environment) GetHidden() bool {
+	if e == nil || e.Hidden == nil {
+		return false
 	}
-	return *i.State
+	return *e.Hidden
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (i *Issue) GetTitle() string {
-	if i == nil || i.Title == nil {
-		return ""
+// GetLock returns the Lock field.
+func (e *Environment) GetLock() *EnvironmentLock {
+	if e == nil {
+		return nil
 	}
-	return *i.Title
+	return e.Lock
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (i *Issue) GetType() string {
-	if i == nil || i.Type == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *Environment) GetName() string {
+	if e == nil || e.Name == nil {
 		return ""
 	}
-	return *i.Type
+	return *e.Name
 }
 
-// GetUpdatedOn returns the UpdatedOn field if it's non-nil, zero value otherwise.
-func (i *Issue) GetUpdatedOn() time.Time {
-	if i == nil || i.UpdatedOn == nil {
-		return time.Time{}
+// GetRank returns the Rank field if it's non-nil, zero value otherwise.
+func (e *Environment) GetRank() int64 {
+	if e == nil || e.Rank == nil {
+		return 0
 	}
-	return *i.UpdatedOn
+	return *e.Rank
 }
 
-// GetVersion returns the Version field.
-func (i *Issue) GetVersion() *Version {
-	if i == nil {
+// GetRestrictions returns the Restrictions field.
+func (e *Environment) GetRestrictions() *EnvironmentRestrictions {
+	if e == nil {
 		return nil
 	}
-	return i.Version
+	return e.Restrictions
 }
 
-// GetVotes returns the Votes field if it's non-nil, zero value otherwise.
-func (i *Issue) GetVotes() int {
-	if i == nil || i.Votes == nil {
-		return 0
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (e *Environment) GetSlug() string {
+	if e == nil || e.Slug == nil {
+		return ""
 	}
-	return *i.Votes
+	return *e.Slug
 }
 
-// GetWatches returns the Watches field if it's non-nil, zero value otherwise.
-func (i *Issue) GetWatches() int {
-	if i == nil || i.Watches == nil {
-		return 0
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (e *Environment) GetType() string {
+	if e == nil || e.Type == nil {
+		return ""
 	}
-	return *i.Watches
+	return *e.Type
 }
 
-// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
-func (i *IssueChange) GetCreatedOn() time.Time {
-	if i == nil || i.CreatedOn == nil {
-		return time.Time{}
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (e *Environment) GetUUID() string {
+	if e == nil || e.UUID == nil {
+		return ""
 	}
-	return *i.CreatedOn
+	return *e.UUID
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IssueChange) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *EnvironmentLock) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
 	}
-	return *i.ID
+	return *e.Name
 }
 
-// GetIssue returns the Issue field.
-func (i *IssueChange) GetIssue() *Issue {
-	if i == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (e *EnvironmentLock) GetType() string {
+	if e == nil || e.Type == nil {
+		return ""
 	}
-	return i.Issue
+	return *e.Type
 }
 
-// GetLinks returns the Links field.
-func (i *IssueChange) GetLinks() *IssueChangeLinks {
-	if i == nil {
+// GetEnvironmentType returns the EnvironmentType field.
+func (e *EnvironmentRequest) GetEnvironmentType() *EnvironmentType {
+	if e == nil {
 		return nil
 	}
-	return i.Links
+	return e.EnvironmentType
 }
 
-// GetMessage returns the Message field.
-func (i *IssueChange) GetMessage() *Content {
-	if i == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *EnvironmentRequest) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
 	}
-	return i.Message
+	return *e.Name
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (i *IssueChange) GetType() string {
-	if i == nil || i.Type == nil {
-		return ""
+// GetRank returns the Rank field if it's non-nil, zero value otherwise.
+func (e *EnvironmentRequest) GetRank() int64 {
+	if e == nil || e.Rank == nil {
+		return 0
 	}
-	return *i.Type
+	return *e.Rank
 }
 
-// GetUser returns the User field.
-func (i *IssueChange) GetUser() *User {
-	if i == nil {
-		return nil
+// GetAdminOnly returns the AdminOnly field if it's non-nil, zero value otherwise.
+func (e *EnvironmentRestrictions) GetAdminOnly() bool {
+	if e == nil || e.AdminOnly == nil {
+		return false
 	}
-	return i.User
+	return *e.AdminOnly
 }
 
-// GetHTML returns the HTML field.
-func (i *IssueChangeLinks) GetHTML() *Link {
-	if i == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (e *EnvironmentRestrictions) GetType() string {
+	if e == nil || e.Type == nil {
+		return ""
 	}
-	return i.HTML
+	return *e.Type
 }
 
-// GetSelf returns the Self field.
-func (i *IssueChangeLinks) GetSelf() *Link {
-	if i == nil {
-		return nil
+// HasValues checks if Environments has any Values.
+func (e *Environments) HasValues() bool {
+	if e == nil || e.Values == nil {
+		return false
 	}
-	return i.Self
-}
 
-// GetAssigneeAccountID returns the AssigneeAccountID field if it's non-nil, zero value otherwise.
-func (i *IssueChangeRequest) GetAssigneeAccountID() string {
-	if i == nil || i.AssigneeAccountID == nil {
-		return ""
+	if len(e.Values) == 0 {
+		return false
 	}
-	return *i.AssigneeAccountID
+	return true
 }
 
-// GetComponent returns the Component field if it's non-nil, zero value otherwise.
-func (i *IssueChangeRequest) GetComponent() string {
-	if i == nil || i.Component == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *EnvironmentType) GetName() string {
+	if e == nil || e.Name == nil {
 		return ""
 	}
-	return *i.Component
+	return *e.Name
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (i *IssueChangeRequest) GetContent() string {
-	if i == nil || i.Content == nil {
-		return ""
+// GetRank returns the Rank field if it's non-nil, zero value otherwise.
+func (e *EnvironmentType) GetRank() int64 {
+	if e == nil || e.Rank == nil {
+		return 0
 	}
-	return *i.Content
+	return *e.Rank
 }
 
-// GetKind returns the Kind field if it's non-nil, zero value otherwise.
-func (i *IssueChangeRequest) GetKind() string {
-	if i == nil || i.Kind == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (e *EnvironmentType) GetType() string {
+	if e == nil || e.Type == nil {
 		return ""
 	}
-	return *i.Kind
+	return *e.Type
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (i *IssueChangeRequest) GetMessage() string {
-	if i == nil || i.Message == nil {
-		return ""
+// GetDeploymentGateEnabled returns the DeploymentGateEnabled field if it's non-nil, zero value otherwise.
+func (e *EnvironmentUpdateRequest) GetDeploymentGateEnabled() bool {
+	if e == nil || e.DeploymentGateEnabled == nil {
+		return false
 	}
-	return *i.Message
+	return *e.DeploymentGateEnabled
 }
 
-// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
-func (i *IssueChangeRequest) GetMilestone() string {
-	if i == nil || i.Milestone == nil {
-		return ""
+// GetEnvironmentLockEnabled returns the EnvironmentLockEnabled field if it's non-nil, zero value otherwise.
+func (e *EnvironmentUpdateRequest) GetEnvironmentLockEnabled() bool {
+	if e == nil || e.EnvironmentLockEnabled == nil {
+		return false
 	}
-	return *i.Milestone
+	return *e.EnvironmentLockEnabled
 }
 
-// GetPriority returns the Priority field if it's non-nil, zero value otherwise.
-func (i *IssueChangeRequest) GetPriority() string {
-	if i == nil || i.Priority == nil {
-		return ""
+// GetHidden returns the Hidden field if it's non-nil, zero value otherwise.
+func (e *EnvironmentUpdateRequest) GetHidden() bool {
+	if e == nil || e.Hidden == nil {
+		return false
 	}
-	return *i.Priority
+	return *e.Hidden
 }
 
-// GetVersion returns the Version field if it's non-nil, zero value otherwise.
-func (i *IssueChangeRequest) GetVersion() string {
-	if i == nil || i.Version == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *EnvironmentUpdateRequest) GetName() string {
+	if e == nil || e.Name == nil {
 		return ""
 	}
-	return *i.Version
+	return *e.Name
 }
 
-// HasValues checks if IssueChanges has any Values.
-func (i *IssueChanges) HasValues() bool {
-	if i == nil || i.Values == nil {
+// GetRank returns the Rank field if it's non-nil, zero value otherwise.
+func (e *EnvironmentUpdateRequest) GetRank() int64 {
+	if e == nil || e.Rank == nil {
+		return 0
+	}
+	return *e.Rank
+}
+
+// GetRestrictions returns the Restrictions field.
+func (e *EnvironmentUpdateRequest) GetRestrictions() *EnvironmentRestrictions {
+	if e == nil {
+		return nil
+	}
+	return e.Restrictions
+}
+
+// GetDetail returns the Detail field.
+func (e *ErrorResponse) GetDetail() *ErrorDetail {
+	if e == nil {
+		return nil
+	}
+	return e.Detail
+}
+
+// HasValues checks if FileHistory has any Values.
+func (f *FileHistory) HasValues() bool {
+	if f == nil || f.Values == nil {
 		return false
 	}
 
-	if len(i.Values) == 0 {
+	if len(f.Values) == 0 {
 		return false
 	}
 	return true
 }
 
-// GetContent returns the Content field.
-func (i *IssueComment) GetContent() *Content {
-	if i == nil {
+// GetHistory returns the History field.
+func (f *FileHistoryLinks) GetHistory() *Link {
+	if f == nil {
 		return nil
 	}
-	return i.Content
+	return f.History
 }
 
-// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetCreatedOn() time.Time {
-	if i == nil || i.CreatedOn == nil {
-		return time.Time{}
+// GetMeta returns the Meta field.
+func (f *FileHistoryLinks) GetMeta() *Link {
+	if f == nil {
+		return nil
 	}
-	return *i.CreatedOn
+	return f.Meta
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
+// GetSelf returns the Self field.
+func (f *FileHistoryLinks) GetSelf() *Link {
+	if f == nil {
+		return nil
 	}
-	return *i.ID
+	return f.Self
 }
 
-// GetIssue returns the Issue field.
-func (i *IssueComment) GetIssue() *Issue {
-	if i == nil {
-		return nil
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (f *ForkRequest) GetDescription() string {
+	if f == nil || f.Description == nil {
+		return ""
 	}
-	return i.Issue
+	return *f.Description
 }
 
-// GetLinks returns the Links field.
-func (i *IssueComment) GetLinks() *IssueCommentLinks {
-	if i == nil {
-		return nil
+// GetForkPolicy returns the ForkPolicy field if it's non-nil, zero value otherwise.
+func (f *ForkRequest) GetForkPolicy() string {
+	if f == nil || f.ForkPolicy == nil {
+		return ""
 	}
-	return i.Links
+	return *f.ForkPolicy
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetType() string {
-	if i == nil || i.Type == nil {
+// GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
+func (f *ForkRequest) GetHasIssues() string {
+	if f == nil || f.HasIssues == nil {
 		return ""
 	}
-	return *i.Type
+	return *f.HasIssues
 }
 
-// GetUpdatedOn returns the UpdatedOn field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetUpdatedOn() time.Time {
-	if i == nil || i.UpdatedOn == nil {
-		return time.Time{}
+// GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
+func (f *ForkRequest) GetHasWiki() bool {
+	if f == nil || f.HasWiki == nil {
+		return false
 	}
-	return *i.UpdatedOn
+	return *f.HasWiki
 }
 
-// GetUser returns the User field.
-func (i *IssueComment) GetUser() *User {
-	if i == nil {
-		return nil
+// GetIsPrivate returns the IsPrivate field if it's non-nil, zero value otherwise.
+func (f *ForkRequest) GetIsPrivate() bool {
+	if f == nil || f.IsPrivate == nil {
+		return false
 	}
-	return i.User
+	return *f.IsPrivate
 }
 
-// GetHTML returns the HTML field.
-func (i *IssueCommentLinks) GetHTML() *Link {
-	if i == nil {
-		return nil
+// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
+func (f *ForkRequest) GetLanguage() string {
+	if f == nil || f.Language == nil {
+		return ""
 	}
-	return i.HTML
+	return *f.Language
 }
 
-// GetSelf returns the Self field.
-func (i *IssueCommentLinks) GetSelf() *Link {
-	if i == nil {
+// GetMainBranch returns the MainBranch field.
+func (f *ForkRequest) GetMainBranch() *RepositoryMainBranch {
+	if f == nil {
 		return nil
 	}
-	return i.Self
+	return f.MainBranch
 }
 
-// GetContent returns the Content field.
-func (i *IssueCommentRequest) GetContent() *Content {
-	if i == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (f *ForkRequest) GetName() string {
+	if f == nil || f.Name == nil {
+		return ""
 	}
-	return i.Content
+	return *f.Name
 }
 
-// HasValues checks if IssueComments has any Values.
-func (i *IssueComments) HasValues() bool {
-	if i == nil || i.Values == nil {
-		return false
+// GetOwner returns the Owner field.
+func (f *ForkRequest) GetOwner() *User {
+	if f == nil {
+		return nil
 	}
+	return f.Owner
+}
 
-	if len(i.Values) == 0 {
-		return false
+// GetParent returns the Parent field.
+func (f *ForkRequest) GetParent() *Repository {
+	if f == nil {
+		return nil
 	}
-	return true
+	return f.Parent
 }
 
-// GetHTML returns the HTML field if it's non-nil, zero value otherwise.
-func (i *IssueContent) GetHTML() string {
-	if i == nil || i.HTML == nil {
+// GetSCM returns the SCM field if it's non-nil, zero value otherwise.
+func (f *ForkRequest) GetSCM() string {
+	if f == nil || f.SCM == nil {
 		return ""
 	}
-	return *i.HTML
+	return *f.SCM
 }
 
-// GetMarkup returns the Markup field if it's non-nil, zero value otherwise.
-func (i *IssueContent) GetMarkup() string {
-	if i == nil || i.Markup == nil {
+// GetCategory returns the Category field if it's non-nil, zero value otherwise.
+func (h *HookEvent) GetCategory() string {
+	if h == nil || h.Category == nil {
 		return ""
 	}
-	return *i.Markup
+	return *h.Category
 }
 
-// GetRaw returns the Raw field if it's non-nil, zero value otherwise.
-func (i *IssueContent) GetRaw() string {
-	if i == nil || i.Raw == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (h *HookEvent) GetDescription() string {
+	if h == nil || h.Description == nil {
 		return ""
 	}
-	return *i.Raw
+	return *h.Description
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (i *IssueContent) GetType() string {
-	if i == nil || i.Type == nil {
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (h *HookEvent) GetEvent() string {
+	if h == nil || h.Event == nil {
 		return ""
 	}
-	return *i.Type
+	return *h.Event
 }
 
-// GetAttachments returns the Attachments field.
-func (i *IssueLinks) GetAttachments() *Link {
-	if i == nil {
-		return nil
+// GetLabel returns the Label field if it's non-nil, zero value otherwise.
+func (h *HookEvent) GetLabel() string {
+	if h == nil || h.Label == nil {
+		return ""
 	}
-	return i.Attachments
+	return *h.Label
 }
 
-// GetComments returns the Comments field.
-func (i *IssueLinks) GetComments() *Link {
-	if i == nil {
-		return nil
+// HasValues checks if HookEvents has any Values.
+func (h *HookEvents) HasValues() bool {
+	if h == nil || h.Values == nil {
+		return false
 	}
-	return i.Comments
+
+	if len(h.Values) == 0 {
+		return false
+	}
+	return true
 }
 
-// GetHTML returns the HTML field.
-func (i *IssueLinks) GetHTML() *Link {
-	if i == nil {
+// GetRepository returns the Repository field.
+func (h *HookEventTypes) GetRepository() *HookEventTypesLinks {
+	if h == nil {
 		return nil
 	}
-	return i.HTML
+	return h.Repository
 }
 
-// GetSelf returns the Self field.
-func (i *IssueLinks) GetSelf() *Link {
-	if i == nil {
+// GetTeam returns the Team field.
+func (h *HookEventTypes) GetTeam() *HookEventTypesLinks {
+	if h == nil {
 		return nil
 	}
-	return i.Self
+	return h.Team
 }
 
-// GetVote returns the Vote field.
-func (i *IssueLinks) GetVote() *Link {
-	if i == nil {
+// GetUser returns the User field.
+func (h *HookEventTypes) GetUser() *HookEventTypesLinks {
+	if h == nil {
 		return nil
 	}
-	return i.Vote
+	return h.User
 }
 
-// GetWatch returns the Watch field.
-func (i *IssueLinks) GetWatch() *Link {
-	if i == nil {
+// GetEvents returns the Events field.
+func (h *HookEventTypesLinks) GetEvents() *Link {
+	if h == nil {
 		return nil
 	}
-	return i.Watch
+	return h.Events
 }
 
 // GetAssignee returns the Assignee field.
-func (i *IssueRequest) GetAssignee() *IssueRequestAssigneeOpts {
+func (i *Issue) GetAssignee() *User {
 	if i == nil {
 		return nil
 	}
@@ -1902,7 +1986,7 @@ func (i *IssueRequest) GetAssignee() *IssueRequestAssigneeOpts {
 }
 
 // GetComponent returns the Component field.
-func (i *IssueRequest) GetComponent() *ComponentRequest {
+func (i *Issue) GetComponent() *Component {
 	if i == nil {
 		return nil
 	}
@@ -1910,939 +1994,3287 @@ func (i *IssueRequest) GetComponent() *ComponentRequest {
 }
 
 // GetContent returns the Content field.
-func (i *IssueRequest) GetContent() *IssueRequestContentOpts {
+func (i *Issue) GetContent() *IssueContent {
 	if i == nil {
 		return nil
 	}
 	return i.Content
 }
 
-// GetKind returns the Kind field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetKind() string {
-	if i == nil || i.Kind == nil {
-		return ""
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (i *Issue) GetCreatedOn() time.Time {
+	if i == nil || i.CreatedOn == nil {
+		return time.Time{}
 	}
-	return *i.Kind
+	return *i.CreatedOn
 }
 
-// GetMilestone returns the Milestone field.
-func (i *IssueRequest) GetMilestone() *MilestoneRequest {
-	if i == nil {
-		return nil
+// GetEditedOn returns the EditedOn field if it's non-nil, zero value otherwise.
+func (i *Issue) GetEditedOn() time.Time {
+	if i == nil || i.EditedOn == nil {
+		return time.Time{}
 	}
-	return i.Milestone
+	return *i.EditedOn
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Issue) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetKind returns the Kind field if it's non-nil, zero value otherwise.
+func (i *Issue) GetKind() string {
+	if i == nil || i.Kind == nil {
+		return ""
+	}
+	return *i.Kind
+}
+
+// GetLinks returns the Links field.
+func (i *Issue) GetLinks() *IssueLinks {
+	if i == nil {
+		return nil
+	}
+	return i.Links
+}
+
+// GetMilestone returns the Milestone field.
+func (i *Issue) GetMilestone() *Milestone {
+	if i == nil {
+		return nil
+	}
+	return i.Milestone
 }
 
 // GetPriority returns the Priority field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetPriority() string {
+func (i *Issue) GetPriority() string {
 	if i == nil || i.Priority == nil {
 		return ""
 	}
 	return *i.Priority
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetTitle() string {
-	if i == nil || i.Title == nil {
-		return ""
+// GetReporter returns the Reporter field.
+func (i *Issue) GetReporter() *User {
+	if i == nil {
+		return nil
 	}
-	return *i.Title
+	return i.Reporter
 }
 
-// GetVersion returns the Version field.
-func (i *IssueRequest) GetVersion() *VersionRequest {
+// GetRepository returns the Repository field.
+func (i *Issue) GetRepository() *Repository {
 	if i == nil {
 		return nil
 	}
-	return i.Version
+	return i.Repository
 }
 
-// GetUsername returns the Username field if it's non-nil, zero value otherwise.
-func (i *IssueRequestAssigneeOpts) GetUsername() string {
-	if i == nil || i.Username == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (i *Issue) GetState() string {
+	if i == nil || i.State == nil {
 		return ""
 	}
-	return *i.Username
+	return *i.State
 }
 
-// GetHTML returns the HTML field if it's non-nil, zero value otherwise.
-func (i *IssueRequestContentOpts) GetHTML() string {
-	if i == nil || i.HTML == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (i *Issue) GetTitle() string {
+	if i == nil || i.Title == nil {
 		return ""
 	}
-	return *i.HTML
+	return *i.Title
 }
 
-// GetRaw returns the Raw field if it's non-nil, zero value otherwise.
-func (i *IssueRequestContentOpts) GetRaw() string {
-	if i == nil || i.Raw == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (i *Issue) GetType() string {
+	if i == nil || i.Type == nil {
 		return ""
 	}
-	return *i.Raw
+	return *i.Type
 }
 
-// HasValues checks if Issues has any Values.
-func (i *Issues) HasValues() bool {
-	if i == nil || i.Values == nil {
-		return false
+// GetUpdatedOn returns the UpdatedOn field if it's non-nil, zero value otherwise.
+func (i *Issue) GetUpdatedOn() time.Time {
+	if i == nil || i.UpdatedOn == nil {
+		return time.Time{}
 	}
+	return *i.UpdatedOn
+}
 
-	if len(i.Values) == 0 {
-		return false
+// GetVersion returns the Version field.
+func (i *Issue) GetVersion() *Version {
+	if i == nil {
+		return nil
 	}
-	return true
+	return i.Version
 }
 
-// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
-func (l *Link) GetHRef() string {
-	if l == nil || l.HRef == nil {
-		return ""
+// GetVotes returns the Votes field if it's non-nil, zero value otherwise.
+func (i *Issue) GetVotes() int {
+	if i == nil || i.Votes == nil {
+		return 0
 	}
-	return *l.HRef
+	return *i.Votes
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (l *Link) GetName() string {
-	if l == nil || l.Name == nil {
-		return ""
+// GetWatches returns the Watches field if it's non-nil, zero value otherwise.
+func (i *Issue) GetWatches() int {
+	if i == nil || i.Watches == nil {
+		return 0
 	}
-	return *l.Name
+	return *i.Watches
 }
 
-// GetCloseSourceBranch returns the CloseSourceBranch field if it's non-nil, zero value otherwise.
-func (m *MergePrRequest) GetCloseSourceBranch() bool {
-	if m == nil || m.CloseSourceBranch == nil {
-		return false
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (i *IssueChange) GetCreatedOn() time.Time {
+	if i == nil || i.CreatedOn == nil {
+		return time.Time{}
 	}
-	return *m.CloseSourceBranch
+	return *i.CreatedOn
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetID() int64 {
-	if m == nil || m.ID == nil {
+func (i *IssueChange) GetID() int64 {
+	if i == nil || i.ID == nil {
 		return 0
 	}
-	return *m.ID
+	return *i.ID
 }
 
-// GetLinks returns the Links field.
-func (m *Milestone) GetLinks() *MilestoneLinks {
-	if m == nil {
+// GetIssue returns the Issue field.
+func (i *IssueChange) GetIssue() *Issue {
+	if i == nil {
 		return nil
 	}
-	return m.Links
+	return i.Issue
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetName() string {
-	if m == nil || m.Name == nil {
-		return ""
+// GetLinks returns the Links field.
+func (i *IssueChange) GetLinks() *IssueChangeLinks {
+	if i == nil {
+		return nil
 	}
-	return *m.Name
+	return i.Links
 }
 
-// GetRepository returns the Repository field.
-func (m *Milestone) GetRepository() *Repository {
-	if m == nil {
+// GetMessage returns the Message field.
+func (i *IssueChange) GetMessage() *Content {
+	if i == nil {
 		return nil
 	}
-	return m.Repository
+	return i.Message
 }
 
 // GetType returns the Type field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetType() string {
-	if m == nil || m.Type == nil {
+func (i *IssueChange) GetType() string {
+	if i == nil || i.Type == nil {
 		return ""
 	}
-	return *m.Type
+	return *i.Type
 }
 
-// GetSelf returns the Self field.
-func (m *MilestoneLinks) GetSelf() *Link {
-	if m == nil {
+// GetUser returns the User field.
+func (i *IssueChange) GetUser() *User {
+	if i == nil {
 		return nil
 	}
-	return m.Self
+	return i.User
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (m *MilestoneRequest) GetName() string {
-	if m == nil || m.Name == nil {
-		return ""
+// GetHTML returns the HTML field.
+func (i *IssueChangeLinks) GetHTML() *Link {
+	if i == nil {
+		return nil
 	}
-	return *m.Name
+	return i.HTML
 }
 
-// HasValues checks if Milestones has any Values.
-func (m *Milestones) HasValues() bool {
-	if m == nil || m.Values == nil {
-		return false
+// GetSelf returns the Self field.
+func (i *IssueChangeLinks) GetSelf() *Link {
+	if i == nil {
+		return nil
 	}
+	return i.Self
+}
 
-	if len(m.Values) == 0 {
-		return false
+// GetAssigneeAccountID returns the AssigneeAccountID field if it's non-nil, zero value otherwise.
+func (i *IssueChangeRequest) GetAssigneeAccountID() string {
+	if i == nil || i.AssigneeAccountID == nil {
+		return ""
 	}
-	return true
+	return *i.AssigneeAccountID
 }
 
-// GetNext returns the Next field if it's non-nil, zero value otherwise.
-func (p *PaginationInfo) GetNext() string {
-	if p == nil || p.Next == nil {
+// GetComponent returns the Component field if it's non-nil, zero value otherwise.
+func (i *IssueChangeRequest) GetComponent() string {
+	if i == nil || i.Component == nil {
 		return ""
 	}
-	return *p.Next
+	return *i.Component
 }
 
-// GetPage returns the Page field if it's non-nil, zero value otherwise.
-func (p *PaginationInfo) GetPage() int64 {
-	if p == nil || p.Page == nil {
-		return 0
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (i *IssueChangeRequest) GetContent() string {
+	if i == nil || i.Content == nil {
+		return ""
 	}
-	return *p.Page
+	return *i.Content
 }
 
-// GetPagelen returns the Pagelen field if it's non-nil, zero value otherwise.
-func (p *PaginationInfo) GetPagelen() int64 {
-	if p == nil || p.Pagelen == nil {
-		return 0
+// GetKind returns the Kind field if it's non-nil, zero value otherwise.
+func (i *IssueChangeRequest) GetKind() string {
+	if i == nil || i.Kind == nil {
+		return ""
 	}
-	return *p.Pagelen
+	return *i.Kind
 }
 
-// GetPrevious returns the Previous field if it's non-nil, zero value otherwise.
-func (p *PaginationInfo) GetPrevious() string {
-	if p == nil || p.Previous == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (i *IssueChangeRequest) GetMessage() string {
+	if i == nil || i.Message == nil {
 		return ""
 	}
-	return *p.Previous
+	return *i.Message
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (p *PaginationInfo) GetSize() int64 {
-	if p == nil || p.Size == nil {
-		return 0
+// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
+func (i *IssueChangeRequest) GetMilestone() string {
+	if i == nil || i.Milestone == nil {
+		return ""
 	}
-	return *p.Size
+	return *i.Milestone
 }
 
-// GetApproved returns the Approved field if it's non-nil, zero value otherwise.
-func (p *Participant) GetApproved() bool {
-	if p == nil || p.Approved == nil {
-		return false
+// GetPriority returns the Priority field if it's non-nil, zero value otherwise.
+func (i *IssueChangeRequest) GetPriority() string {
+	if i == nil || i.Priority == nil {
+		return ""
 	}
-	return *p.Approved
+	return *i.Priority
 }
 
-// GetParticipatedOn returns the ParticipatedOn field if it's non-nil, zero value otherwise.
-func (p *Participant) GetParticipatedOn() time.Time {
-	if p == nil || p.ParticipatedOn == nil {
-		return time.Time{}
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (i *IssueChangeRequest) GetVersion() string {
+	if i == nil || i.Version == nil {
+		return ""
 	}
-	return *p.ParticipatedOn
+	return *i.Version
 }
 
-// GetRole returns the Role field if it's non-nil, zero value otherwise.
-func (p *Participant) GetRole() string {
-	if p == nil || p.Role == nil {
-		return ""
+// HasValues checks if IssueChanges has any Values.
+func (i *IssueChanges) HasValues() bool {
+	if i == nil || i.Values == nil {
+		return false
 	}
-	return *p.Role
-}
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (p *Participant) GetType() string {
-	if p == nil || p.Type == nil {
-		return ""
+	if len(i.Values) == 0 {
+		return false
 	}
-	return *p.Type
+	return true
 }
 
-// GetUser returns the User field.
-func (p *Participant) GetUser() *User {
-	if p == nil {
+// GetContent returns the Content field.
+func (i *IssueComment) GetContent() *Content {
+	if i == nil {
 		return nil
 	}
-	return p.User
+	return i.Content
 }
 
-// HasValues checks if PRActivities has any Values.
-func (p *PRActivities) HasValues() bool {
-	if p == nil || p.Values == nil {
-		return false
-	}
-
-	if len(p.Values) == 0 {
-		return false
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetCreatedOn() time.Time {
+	if i == nil || i.CreatedOn == nil {
+		return time.Time{}
 	}
-	return true
+	return *i.CreatedOn
 }
 
-// GetApproval returns the Approval field.
-func (p *PRActivity) GetApproval() *PRApprovalActivity {
-	if p == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
 	}
-	return p.Approval
+	return *i.ID
 }
 
-// GetPullRequest returns the PullRequest field.
-func (p *PRActivity) GetPullRequest() *PullRequest {
-	if p == nil {
+// GetIssue returns the Issue field.
+func (i *IssueComment) GetIssue() *Issue {
+	if i == nil {
 		return nil
 	}
-	return p.PullRequest
+	return i.Issue
 }
 
-// GetUpdate returns the Update field.
-func (p *PRActivity) GetUpdate() *PRUpdateActivity {
-	if p == nil {
+// GetLinks returns the Links field.
+func (i *IssueComment) GetLinks() *IssueCommentLinks {
+	if i == nil {
 		return nil
 	}
-	return p.Update
+	return i.Links
 }
 
-// GetDate returns the Date field if it's non-nil, zero value otherwise.
-func (p *PRApprovalActivity) GetDate() time.Time {
-	if p == nil || p.Date == nil {
-		return time.Time{}
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetType() string {
+	if i == nil || i.Type == nil {
+		return ""
 	}
-	return *p.Date
+	return *i.Type
 }
 
-// GetPullRequest returns the PullRequest field.
-func (p *PRApprovalActivity) GetPullRequest() *PullRequest {
-	if p == nil {
-		return nil
+// GetUpdatedOn returns the UpdatedOn field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetUpdatedOn() time.Time {
+	if i == nil || i.UpdatedOn == nil {
+		return time.Time{}
 	}
-	return p.PullRequest
+	return *i.UpdatedOn
 }
 
 // GetUser returns the User field.
-func (p *PRApprovalActivity) GetUser() *User {
-	if p == nil {
+func (i *IssueComment) GetUser() *User {
+	if i == nil {
 		return nil
 	}
-	return p.User
+	return i.User
 }
 
-// GetDeleted returns the Deleted field if it's non-nil, zero value otherwise.
-func (p *PRComment) GetDeleted() bool {
-	if p == nil || p.Deleted == nil {
-		return false
+// GetHTML returns the HTML field.
+func (i *IssueCommentLinks) GetHTML() *Link {
+	if i == nil {
+		return nil
 	}
-	return *p.Deleted
+	return i.HTML
 }
 
-// GetPullRequest returns the PullRequest field.
-func (p *PRComment) GetPullRequest() *PullRequest {
-	if p == nil {
+// GetSelf returns the Self field.
+func (i *IssueCommentLinks) GetSelf() *Link {
+	if i == nil {
 		return nil
 	}
-	return p.PullRequest
+	return i.Self
 }
 
 // GetContent returns the Content field.
-func (p *PRCommentRequest) GetContent() *Content {
-	if p == nil {
+func (i *IssueCommentRequest) GetContent() *Content {
+	if i == nil {
 		return nil
 	}
-	return p.Content
+	return i.Content
 }
 
-// HasValues checks if PRComments has any Values.
-func (p *PRComments) HasValues() bool {
-	if p == nil || p.Values == nil {
+// HasValues checks if IssueComments has any Values.
+func (i *IssueComments) HasValues() bool {
+	if i == nil || i.Values == nil {
 		return false
 	}
 
-	if len(p.Values) == 0 {
+	if len(i.Values) == 0 {
 		return false
 	}
 	return true
 }
 
-// GetCloseSourceBranch returns the CloseSourceBranch field if it's non-nil, zero value otherwise.
-func (p *PRRequest) GetCloseSourceBranch() bool {
-	if p == nil || p.CloseSourceBranch == nil {
-		return false
+// GetHTML returns the HTML field if it's non-nil, zero value otherwise.
+func (i *IssueContent) GetHTML() string {
+	if i == nil || i.HTML == nil {
+		return ""
 	}
-	return *p.CloseSourceBranch
+	return *i.HTML
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (p *PRRequest) GetDescription() string {
-	if p == nil || p.Description == nil {
+// GetMarkup returns the Markup field if it's non-nil, zero value otherwise.
+func (i *IssueContent) GetMarkup() string {
+	if i == nil || i.Markup == nil {
 		return ""
 	}
-	return *p.Description
+	return *i.Markup
 }
 
-// GetDestination returns the Destination field.
-func (p *PRRequest) GetDestination() *PRRequestDestinationOpts {
-	if p == nil {
-		return nil
+// GetRaw returns the Raw field if it's non-nil, zero value otherwise.
+func (i *IssueContent) GetRaw() string {
+	if i == nil || i.Raw == nil {
+		return ""
 	}
-	return p.Destination
+	return *i.Raw
 }
 
-// HasReviewers checks if PRRequest has any Reviewers.
-func (p *PRRequest) HasReviewers() bool {
-	if p == nil || p.Reviewers == nil {
-		return false
-	}
-
-	if len(p.Reviewers) == 0 {
-		return false
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (i *IssueContent) GetType() string {
+	if i == nil || i.Type == nil {
+		return ""
 	}
-	return true
+	return *i.Type
 }
 
-// GetSource returns the Source field.
-func (p *PRRequest) GetSource() *PRRequestSourceOpts {
-	if p == nil {
+// GetAttachments returns the Attachments field.
+func (i *IssueLinks) GetAttachments() *Link {
+	if i == nil {
 		return nil
 	}
-	return p.Source
+	return i.Attachments
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (p *PRRequest) GetTitle() string {
-	if p == nil || p.Title == nil {
-		return ""
+// GetComments returns the Comments field.
+func (i *IssueLinks) GetComments() *Link {
+	if i == nil {
+		return nil
 	}
-	return *p.Title
+	return i.Comments
 }
 
-// GetBranch returns the Branch field.
-func (p *PRRequestDestinationOpts) GetBranch() *Branch {
-	if p == nil {
+// GetHTML returns the HTML field.
+func (i *IssueLinks) GetHTML() *Link {
+	if i == nil {
 		return nil
 	}
-	return p.Branch
+	return i.HTML
 }
 
-// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
-func (p *PRRequestReviewerOpts) GetUUID() string {
-	if p == nil || p.UUID == nil {
-		return ""
+// GetSelf returns the Self field.
+func (i *IssueLinks) GetSelf() *Link {
+	if i == nil {
+		return nil
 	}
-	return *p.UUID
+	return i.Self
 }
 
-// GetBranch returns the Branch field.
-func (p *PRRequestSourceOpts) GetBranch() *Branch {
-	if p == nil {
+// GetVote returns the Vote field.
+func (i *IssueLinks) GetVote() *Link {
+	if i == nil {
 		return nil
 	}
-	return p.Branch
+	return i.Vote
 }
 
-// GetAuthor returns the Author field.
-func (p *PRUpdateActivity) GetAuthor() *User {
-	if p == nil {
+// GetWatch returns the Watch field.
+func (i *IssueLinks) GetWatch() *Link {
+	if i == nil {
 		return nil
 	}
-	return p.Author
+	return i.Watch
 }
 
-// GetDate returns the Date field if it's non-nil, zero value otherwise.
-func (p *PRUpdateActivity) GetDate() time.Time {
-	if p == nil || p.Date == nil {
-		return time.Time{}
+// GetAssignee returns the Assignee field.
+func (i *IssueRequest) GetAssignee() *IssueRequestAssigneeOpts {
+	if i == nil {
+		return nil
 	}
-	return *p.Date
+	return i.Assignee
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (p *PRUpdateActivity) GetDescription() string {
-	if p == nil || p.Description == nil {
-		return ""
+// GetComponent returns the Component field.
+func (i *IssueRequest) GetComponent() *ComponentRequest {
+	if i == nil {
+		return nil
 	}
-	return *p.Description
+	return i.Component
 }
 
-// GetDestination returns the Destination field.
-func (p *PRUpdateActivity) GetDestination() *PullRequestBranch {
-	if p == nil {
+// GetContent returns the Content field.
+func (i *IssueRequest) GetContent() *IssueRequestContentOpts {
+	if i == nil {
 		return nil
 	}
-	return p.Destination
+	return i.Content
 }
 
-// GetReason returns the Reason field if it's non-nil, zero value otherwise.
-func (p *PRUpdateActivity) GetReason() string {
-	if p == nil || p.Reason == nil {
+// GetKind returns the Kind field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetKind() string {
+	if i == nil || i.Kind == nil {
+		return ""
+	}
+	return *i.Kind
+}
+
+// GetMilestone returns the Milestone field.
+func (i *IssueRequest) GetMilestone() *MilestoneRequest {
+	if i == nil {
+		return nil
+	}
+	return i.Milestone
+}
+
+// GetPriority returns the Priority field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetPriority() string {
+	if i == nil || i.Priority == nil {
+		return ""
+	}
+	return *i.Priority
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetTitle() string {
+	if i == nil || i.Title == nil {
+		return ""
+	}
+	return *i.Title
+}
+
+// GetVersion returns the Version field.
+func (i *IssueRequest) GetVersion() *VersionRequest {
+	if i == nil {
+		return nil
+	}
+	return i.Version
+}
+
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (i *IssueRequestAssigneeOpts) GetUsername() string {
+	if i == nil || i.Username == nil {
+		return ""
+	}
+	return *i.Username
+}
+
+// GetHTML returns the HTML field if it's non-nil, zero value otherwise.
+func (i *IssueRequestContentOpts) GetHTML() string {
+	if i == nil || i.HTML == nil {
+		return ""
+	}
+	return *i.HTML
+}
+
+// GetRaw returns the Raw field if it's non-nil, zero value otherwise.
+func (i *IssueRequestContentOpts) GetRaw() string {
+	if i == nil || i.Raw == nil {
+		return ""
+	}
+	return *i.Raw
+}
+
+// HasValues checks if Issues has any Values.
+func (i *Issues) HasValues() bool {
+	if i == nil || i.Values == nil {
+		return false
+	}
+
+	if len(i.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
+func (l *Link) GetHRef() string {
+	if l == nil || l.HRef == nil {
+		return ""
+	}
+	return *l.HRef
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *Link) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// HasBlockers checks if MergeCheck has any Blockers.
+func (m *MergeCheck) HasBlockers() bool {
+	if m == nil || m.Blockers == nil {
+		return false
+	}
+
+	if len(m.Blockers) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetPullRequest returns the PullRequest field.
+func (m *MergeCheck) GetPullRequest() *PullRequest {
+	if m == nil {
+		return nil
+	}
+	return m.PullRequest
+}
+
+// GetCloseSourceBranch returns the CloseSourceBranch field if it's non-nil, zero value otherwise.
+func (m *MergePrRequest) GetCloseSourceBranch() bool {
+	if m == nil || m.CloseSourceBranch == nil {
+		return false
+	}
+	return *m.CloseSourceBranch
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetLinks returns the Links field.
+func (m *Milestone) GetLinks() *MilestoneLinks {
+	if m == nil {
+		return nil
+	}
+	return m.Links
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetName() string {
+	if m == nil || m.Name == nil {
+		return ""
+	}
+	return *m.Name
+}
+
+// GetRepository returns the Repository field.
+func (m *Milestone) GetRepository() *Repository {
+	if m == nil {
+		return nil
+	}
+	return m.Repository
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetType() string {
+	if m == nil || m.Type == nil {
+		return ""
+	}
+	return *m.Type
+}
+
+// GetSelf returns the Self field.
+func (m *MilestoneLinks) GetSelf() *Link {
+	if m == nil {
+		return nil
+	}
+	return m.Self
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (m *MilestoneRequest) GetName() string {
+	if m == nil || m.Name == nil {
+		return ""
+	}
+	return *m.Name
+}
+
+// HasValues checks if Milestones has any Values.
+func (m *Milestones) HasValues() bool {
+	if m == nil || m.Values == nil {
+		return false
+	}
+
+	if len(m.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetNext returns the Next field if it's non-nil, zero value otherwise.
+func (p *PaginationInfo) GetNext() string {
+	if p == nil || p.Next == nil {
+		return ""
+	}
+	return *p.Next
+}
+
+// GetPage returns the Page field if it's non-nil, zero value otherwise.
+func (p *PaginationInfo) GetPage() int64 {
+	if p == nil || p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+// GetPagelen returns the Pagelen field if it's non-nil, zero value otherwise.
+func (p *PaginationInfo) GetPagelen() int64 {
+	if p == nil || p.Pagelen == nil {
+		return 0
+	}
+	return *p.Pagelen
+}
+
+// GetPrevious returns the Previous field if it's non-nil, zero value otherwise.
+func (p *PaginationInfo) GetPrevious() string {
+	if p == nil || p.Previous == nil {
+		return ""
+	}
+	return *p.Previous
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (p *PaginationInfo) GetSize() int64 {
+	if p == nil || p.Size == nil {
+		return 0
+	}
+	return *p.Size
+}
+
+// GetApproved returns the Approved field if it's non-nil, zero value otherwise.
+func (p *Participant) GetApproved() bool {
+	if p == nil || p.Approved == nil {
+		return false
+	}
+	return *p.Approved
+}
+
+// GetParticipatedOn returns the ParticipatedOn field if it's non-nil, zero value otherwise.
+func (p *Participant) GetParticipatedOn() time.Time {
+	if p == nil || p.ParticipatedOn == nil {
+		return time.Time{}
+	}
+	return *p.ParticipatedOn
+}
+
+// GetRole returns the Role field if it's non-nil, zero value otherwise.
+func (p *Participant) GetRole() string {
+	if p == nil || p.Role == nil {
+		return ""
+	}
+	return *p.Role
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *Participant) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *Participant) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetUser returns the User field.
+func (p *Participant) GetUser() *User {
+	if p == nil {
+		return nil
+	}
+	return p.User
+}
+
+// GetBuildNumber returns the BuildNumber field if it's non-nil, zero value otherwise.
+func (p *Pipeline) GetBuildNumber() int64 {
+	if p == nil || p.BuildNumber == nil {
+		return 0
+	}
+	return *p.BuildNumber
+}
+
+// GetBuildSecondsUsed returns the BuildSecondsUsed field if it's non-nil, zero value otherwise.
+func (p *Pipeline) GetBuildSecondsUsed() int64 {
+	if p == nil || p.BuildSecondsUsed == nil {
+		return 0
+	}
+	return *p.BuildSecondsUsed
+}
+
+// GetCompletedOn returns the CompletedOn field if it's non-nil, zero value otherwise.
+func (p *Pipeline) GetCompletedOn() time.Time {
+	if p == nil || p.CompletedOn == nil {
+		return time.Time{}
+	}
+	return *p.CompletedOn
+}
+
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (p *Pipeline) GetCreatedOn() time.Time {
+	if p == nil || p.CreatedOn == nil {
+		return time.Time{}
+	}
+	return *p.CreatedOn
+}
+
+// GetCreator returns the Creator field.
+func (p *Pipeline) GetCreator() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Creator
+}
+
+// GetDurationInSeconds returns the DurationInSeconds field if it's non-nil, zero value otherwise.
+func (p *Pipeline) GetDurationInSeconds() int64 {
+	if p == nil || p.DurationInSeconds == nil {
+		return 0
+	}
+	return *p.DurationInSeconds
+}
+
+// GetLinks returns the Links field.
+func (p *Pipeline) GetLinks() *PipelineLinks {
+	if p == nil {
+		return nil
+	}
+	return p.Links
+}
+
+// GetRepository returns the Repository field.
+func (p *Pipeline) GetRepository() *Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repository
+}
+
+// GetState returns the State field.
+func (p *Pipeline) GetState() *PipelineState {
+	if p == nil {
+		return nil
+	}
+	return p.State
+}
+
+// GetTarget returns the Target field.
+func (p *Pipeline) GetTarget() *PipelineTarget {
+	if p == nil {
+		return nil
+	}
+	return p.Target
+}
+
+// GetTrigger returns the Trigger field.
+func (p *Pipeline) GetTrigger() *PipelineTrigger {
+	if p == nil {
+		return nil
+	}
+	return p.Trigger
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *Pipeline) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (p *Pipeline) GetUUID() string {
+	if p == nil || p.UUID == nil {
+		return ""
+	}
+	return *p.UUID
+}
+
+// HasVariables checks if Pipeline has any Variables.
+func (p *Pipeline) HasVariables() bool {
+	if p == nil || p.Variables == nil {
+		return false
+	}
+
+	if len(p.Variables) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetNext returns the Next field if it's non-nil, zero value otherwise.
+func (p *PipelineBuildNumber) GetNext() int64 {
+	if p == nil || p.Next == nil {
+		return 0
+	}
+	return *p.Next
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineBuildNumber) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *PipelineCommand) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
+	}
+	return *p.Action
+}
+
+// GetCommand returns the Command field if it's non-nil, zero value otherwise.
+func (p *PipelineCommand) GetCommand() string {
+	if p == nil || p.Command == nil {
+		return ""
+	}
+	return *p.Command
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PipelineCommand) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (p *PipelineImage) GetEmail() string {
+	if p == nil || p.Email == nil {
+		return ""
+	}
+	return *p.Email
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PipelineImage) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (p *PipelineImage) GetUsername() string {
+	if p == nil || p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+// GetHostname returns the Hostname field if it's non-nil, zero value otherwise.
+func (p *PipelineKnownHost) GetHostname() string {
+	if p == nil || p.Hostname == nil {
+		return ""
+	}
+	return *p.Hostname
+}
+
+// GetPublicKey returns the PublicKey field.
+func (p *PipelineKnownHost) GetPublicKey() *PipelineSSHPublicKey {
+	if p == nil {
+		return nil
+	}
+	return p.PublicKey
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineKnownHost) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (p *PipelineKnownHost) GetUUID() string {
+	if p == nil || p.UUID == nil {
+		return ""
+	}
+	return *p.UUID
+}
+
+// GetHostname returns the Hostname field if it's non-nil, zero value otherwise.
+func (p *PipelineKnownHostRequest) GetHostname() string {
+	if p == nil || p.Hostname == nil {
+		return ""
+	}
+	return *p.Hostname
+}
+
+// GetPublicKey returns the PublicKey field.
+func (p *PipelineKnownHostRequest) GetPublicKey() *PipelineSSHPublicKey {
+	if p == nil {
+		return nil
+	}
+	return p.PublicKey
+}
+
+// HasValues checks if PipelineKnownHosts has any Values.
+func (p *PipelineKnownHosts) HasValues() bool {
+	if p == nil || p.Values == nil {
+		return false
+	}
+
+	if len(p.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetSelf returns the Self field.
+func (p *PipelineLinks) GetSelf() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.Self
+}
+
+// GetSteps returns the Steps field.
+func (p *PipelineLinks) GetSteps() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.Steps
+}
+
+// GetTarget returns the Target field.
+func (p *PipelineRequest) GetTarget() *PipelineTarget {
+	if p == nil {
+		return nil
+	}
+	return p.Target
+}
+
+// HasVariables checks if PipelineRequest has any Variables.
+func (p *PipelineRequest) HasVariables() bool {
+	if p == nil || p.Variables == nil {
+		return false
+	}
+
+	if len(p.Variables) == 0 {
+		return false
+	}
+	return true
+}
+
+// HasValues checks if Pipelines has any Values.
+func (p *Pipelines) HasValues() bool {
+	if p == nil || p.Values == nil {
+		return false
+	}
+
+	if len(p.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (p *PipelineSchedule) GetCreatedOn() time.Time {
+	if p == nil || p.CreatedOn == nil {
+		return time.Time{}
+	}
+	return *p.CreatedOn
+}
+
+// GetCronPattern returns the CronPattern field if it's non-nil, zero value otherwise.
+func (p *PipelineSchedule) GetCronPattern() string {
+	if p == nil || p.CronPattern == nil {
+		return ""
+	}
+	return *p.CronPattern
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (p *PipelineSchedule) GetEnabled() bool {
+	if p == nil || p.Enabled == nil {
+		return false
+	}
+	return *p.Enabled
+}
+
+// GetTarget returns the Target field.
+func (p *PipelineSchedule) GetTarget() *PipelineTarget {
+	if p == nil {
+		return nil
+	}
+	return p.Target
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineSchedule) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetUpdatedOn returns the UpdatedOn field if it's non-nil, zero value otherwise.
+func (p *PipelineSchedule) GetUpdatedOn() time.Time {
+	if p == nil || p.UpdatedOn == nil {
+		return time.Time{}
+	}
+	return *p.UpdatedOn
+}
+
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (p *PipelineSchedule) GetUUID() string {
+	if p == nil || p.UUID == nil {
+		return ""
+	}
+	return *p.UUID
+}
+
+// GetPipeline returns the Pipeline field.
+func (p *PipelineScheduleExecution) GetPipeline() *Pipeline {
+	if p == nil {
+		return nil
+	}
+	return p.Pipeline
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineScheduleExecution) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// HasValues checks if PipelineScheduleExecutions has any Values.
+func (p *PipelineScheduleExecutions) HasValues() bool {
+	if p == nil || p.Values == nil {
+		return false
+	}
+
+	if len(p.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetCronPattern returns the CronPattern field if it's non-nil, zero value otherwise.
+func (p *PipelineScheduleRequest) GetCronPattern() string {
+	if p == nil || p.CronPattern == nil {
+		return ""
+	}
+	return *p.CronPattern
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (p *PipelineScheduleRequest) GetEnabled() bool {
+	if p == nil || p.Enabled == nil {
+		return false
+	}
+	return *p.Enabled
+}
+
+// GetTarget returns the Target field.
+func (p *PipelineScheduleRequest) GetTarget() *PipelineTarget {
+	if p == nil {
+		return nil
+	}
+	return p.Target
+}
+
+// HasValues checks if PipelineSchedules has any Values.
+func (p *PipelineSchedules) HasValues() bool {
+	if p == nil || p.Values == nil {
+		return false
+	}
+
+	if len(p.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (p *PipelinesConfig) GetEnabled() bool {
+	if p == nil || p.Enabled == nil {
+		return false
+	}
+	return *p.Enabled
+}
+
+// GetRepository returns the Repository field.
+func (p *PipelinesConfig) GetRepository() *Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repository
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelinesConfig) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (p *PipelinesConfigRequest) GetEnabled() bool {
+	if p == nil || p.Enabled == nil {
+		return false
+	}
+	return *p.Enabled
+}
+
+// GetPattern returns the Pattern field if it's non-nil, zero value otherwise.
+func (p *PipelineSelector) GetPattern() string {
+	if p == nil || p.Pattern == nil {
+		return ""
+	}
+	return *p.Pattern
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineSelector) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetPrivateKey returns the PrivateKey field if it's non-nil, zero value otherwise.
+func (p *PipelineSSHKeyPair) GetPrivateKey() string {
+	if p == nil || p.PrivateKey == nil {
+		return ""
+	}
+	return *p.PrivateKey
+}
+
+// GetPublicKey returns the PublicKey field if it's non-nil, zero value otherwise.
+func (p *PipelineSSHKeyPair) GetPublicKey() string {
+	if p == nil || p.PublicKey == nil {
+		return ""
+	}
+	return *p.PublicKey
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineSSHKeyPair) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetPrivateKey returns the PrivateKey field if it's non-nil, zero value otherwise.
+func (p *PipelineSSHKeyPairRequest) GetPrivateKey() string {
+	if p == nil || p.PrivateKey == nil {
+		return ""
+	}
+	return *p.PrivateKey
+}
+
+// GetPublicKey returns the PublicKey field if it's non-nil, zero value otherwise.
+func (p *PipelineSSHKeyPairRequest) GetPublicKey() string {
+	if p == nil || p.PublicKey == nil {
+		return ""
+	}
+	return *p.PublicKey
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (p *PipelineSSHPublicKey) GetKey() string {
+	if p == nil || p.Key == nil {
+		return ""
+	}
+	return *p.Key
+}
+
+// GetKeyType returns the KeyType field if it's non-nil, zero value otherwise.
+func (p *PipelineSSHPublicKey) GetKeyType() string {
+	if p == nil || p.KeyType == nil {
+		return ""
+	}
+	return *p.KeyType
+}
+
+// GetMD5Fingerprint returns the MD5Fingerprint field if it's non-nil, zero value otherwise.
+func (p *PipelineSSHPublicKey) GetMD5Fingerprint() string {
+	if p == nil || p.MD5Fingerprint == nil {
+		return ""
+	}
+	return *p.MD5Fingerprint
+}
+
+// GetSHA256Fingerprint returns the SHA256Fingerprint field if it's non-nil, zero value otherwise.
+func (p *PipelineSSHPublicKey) GetSHA256Fingerprint() string {
+	if p == nil || p.SHA256Fingerprint == nil {
+		return ""
+	}
+	return *p.SHA256Fingerprint
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineSSHPublicKey) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PipelineState) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetResult returns the Result field.
+func (p *PipelineState) GetResult() *PipelineStateDetail {
+	if p == nil {
+		return nil
+	}
+	return p.Result
+}
+
+// GetStage returns the Stage field.
+func (p *PipelineState) GetStage() *PipelineStateDetail {
+	if p == nil {
+		return nil
+	}
+	return p.Stage
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineState) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PipelineStateDetail) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineStateDetail) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetBuildSecondsUsed returns the BuildSecondsUsed field if it's non-nil, zero value otherwise.
+func (p *PipelineStep) GetBuildSecondsUsed() int64 {
+	if p == nil || p.BuildSecondsUsed == nil {
+		return 0
+	}
+	return *p.BuildSecondsUsed
+}
+
+// GetCompletedOn returns the CompletedOn field if it's non-nil, zero value otherwise.
+func (p *PipelineStep) GetCompletedOn() time.Time {
+	if p == nil || p.CompletedOn == nil {
+		return time.Time{}
+	}
+	return *p.CompletedOn
+}
+
+// GetDurationInSecs returns the DurationInSecs field if it's non-nil, zero value otherwise.
+func (p *PipelineStep) GetDurationInSecs() int64 {
+	if p == nil || p.DurationInSecs == nil {
+		return 0
+	}
+	return *p.DurationInSecs
+}
+
+// GetEnvironment returns the Environment field.
+func (p *PipelineStep) GetEnvironment() *PipelineStepReference {
+	if p == nil {
+		return nil
+	}
+	return p.Environment
+}
+
+// GetImage returns the Image field.
+func (p *PipelineStep) GetImage() *PipelineImage {
+	if p == nil {
+		return nil
+	}
+	return p.Image
+}
+
+// GetMaxTime returns the MaxTime field if it's non-nil, zero value otherwise.
+func (p *PipelineStep) GetMaxTime() int64 {
+	if p == nil || p.MaxTime == nil {
+		return 0
+	}
+	return *p.MaxTime
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PipelineStep) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetPipeline returns the Pipeline field.
+func (p *PipelineStep) GetPipeline() *Pipeline {
+	if p == nil {
+		return nil
+	}
+	return p.Pipeline
+}
+
+// GetRunNumber returns the RunNumber field if it's non-nil, zero value otherwise.
+func (p *PipelineStep) GetRunNumber() int64 {
+	if p == nil || p.RunNumber == nil {
+		return 0
+	}
+	return *p.RunNumber
+}
+
+// HasScriptCommands checks if PipelineStep has any ScriptCommands.
+func (p *PipelineStep) HasScriptCommands() bool {
+	if p == nil || p.ScriptCommands == nil {
+		return false
+	}
+
+	if len(p.ScriptCommands) == 0 {
+		return false
+	}
+	return true
+}
+
+// HasSetupCommands checks if PipelineStep has any SetupCommands.
+func (p *PipelineStep) HasSetupCommands() bool {
+	if p == nil || p.SetupCommands == nil {
+		return false
+	}
+
+	if len(p.SetupCommands) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetStartedOn returns the StartedOn field if it's non-nil, zero value otherwise.
+func (p *PipelineStep) GetStartedOn() time.Time {
+	if p == nil || p.StartedOn == nil {
+		return time.Time{}
+	}
+	return *p.StartedOn
+}
+
+// GetState returns the State field.
+func (p *PipelineStep) GetState() *PipelineState {
+	if p == nil {
+		return nil
+	}
+	return p.State
+}
+
+// HasTeardownCommands checks if PipelineStep has any TeardownCommands.
+func (p *PipelineStep) HasTeardownCommands() bool {
+	if p == nil || p.TeardownCommands == nil {
+		return false
+	}
+
+	if len(p.TeardownCommands) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetTrigger returns the Trigger field.
+func (p *PipelineStep) GetTrigger() *PipelineStepTrigger {
+	if p == nil {
+		return nil
+	}
+	return p.Trigger
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineStep) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (p *PipelineStep) GetUUID() string {
+	if p == nil || p.UUID == nil {
+		return ""
+	}
+	return *p.UUID
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineStepReference) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (p *PipelineStepReference) GetUUID() string {
+	if p == nil || p.UUID == nil {
+		return ""
+	}
+	return *p.UUID
+}
+
+// HasValues checks if PipelineSteps has any Values.
+func (p *PipelineSteps) HasValues() bool {
+	if p == nil || p.Values == nil {
+		return false
+	}
+
+	if len(p.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineStepTrigger) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetCommit returns the Commit field.
+func (p *PipelineTarget) GetCommit() *Commit {
+	if p == nil {
+		return nil
+	}
+	return p.Commit
+}
+
+// GetDestination returns the Destination field if it's non-nil, zero value otherwise.
+func (p *PipelineTarget) GetDestination() string {
+	if p == nil || p.Destination == nil {
+		return ""
+	}
+	return *p.Destination
+}
+
+// GetPullRequest returns the PullRequest field.
+func (p *PipelineTarget) GetPullRequest() *PullRequest {
+	if p == nil {
+		return nil
+	}
+	return p.PullRequest
+}
+
+// GetRefName returns the RefName field if it's non-nil, zero value otherwise.
+func (p *PipelineTarget) GetRefName() string {
+	if p == nil || p.RefName == nil {
+		return ""
+	}
+	return *p.RefName
+}
+
+// GetRefType returns the RefType field if it's non-nil, zero value otherwise.
+func (p *PipelineTarget) GetRefType() string {
+	if p == nil || p.RefType == nil {
+		return ""
+	}
+	return *p.RefType
+}
+
+// GetSelector returns the Selector field.
+func (p *PipelineTarget) GetSelector() *PipelineSelector {
+	if p == nil {
+		return nil
+	}
+	return p.Selector
+}
+
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (p *PipelineTarget) GetSource() string {
+	if p == nil || p.Source == nil {
+		return ""
+	}
+	return *p.Source
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineTarget) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
+func (p *PipelineTestCase) GetDuration() string {
+	if p == nil || p.Duration == nil {
+		return ""
+	}
+	return *p.Duration
+}
+
+// GetFullyQualifiedName returns the FullyQualifiedName field if it's non-nil, zero value otherwise.
+func (p *PipelineTestCase) GetFullyQualifiedName() string {
+	if p == nil || p.FullyQualifiedName == nil {
+		return ""
+	}
+	return *p.FullyQualifiedName
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PipelineTestCase) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetPackageName returns the PackageName field if it's non-nil, zero value otherwise.
+func (p *PipelineTestCase) GetPackageName() string {
+	if p == nil || p.PackageName == nil {
+		return ""
+	}
+	return *p.PackageName
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *PipelineTestCase) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
+	}
+	return *p.Status
+}
+
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (p *PipelineTestCase) GetUUID() string {
+	if p == nil || p.UUID == nil {
+		return ""
+	}
+	return *p.UUID
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PipelineTestCaseReason) GetMessage() string {
+	if p == nil || p.Message == nil {
+		return ""
+	}
+	return *p.Message
+}
+
+// GetOutput returns the Output field if it's non-nil, zero value otherwise.
+func (p *PipelineTestCaseReason) GetOutput() string {
+	if p == nil || p.Output == nil {
+		return ""
+	}
+	return *p.Output
+}
+
+// HasValues checks if PipelineTestCaseReasons has any Values.
+func (p *PipelineTestCaseReasons) HasValues() bool {
+	if p == nil || p.Values == nil {
+		return false
+	}
+
+	if len(p.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// HasValues checks if PipelineTestCases has any Values.
+func (p *PipelineTestCases) HasValues() bool {
+	if p == nil || p.Values == nil {
+		return false
+	}
+
+	if len(p.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetNumberOfErrorTestCases returns the NumberOfErrorTestCases field if it's non-nil, zero value otherwise.
+func (p *PipelineTestReport) GetNumberOfErrorTestCases() int64 {
+	if p == nil || p.NumberOfErrorTestCases == nil {
+		return 0
+	}
+	return *p.NumberOfErrorTestCases
+}
+
+// GetNumberOfFailedTestCases returns the NumberOfFailedTestCases field if it's non-nil, zero value otherwise.
+func (p *PipelineTestReport) GetNumberOfFailedTestCases() int64 {
+	if p == nil || p.NumberOfFailedTestCases == nil {
+		return 0
+	}
+	return *p.NumberOfFailedTestCases
+}
+
+// GetNumberOfSkippedTestCases returns the NumberOfSkippedTestCases field if it's non-nil, zero value otherwise.
+func (p *PipelineTestReport) GetNumberOfSkippedTestCases() int64 {
+	if p == nil || p.NumberOfSkippedTestCases == nil {
+		return 0
+	}
+	return *p.NumberOfSkippedTestCases
+}
+
+// GetNumberOfSuccessfulTestCases returns the NumberOfSuccessfulTestCases field if it's non-nil, zero value otherwise.
+func (p *PipelineTestReport) GetNumberOfSuccessfulTestCases() int64 {
+	if p == nil || p.NumberOfSuccessfulTestCases == nil {
+		return 0
+	}
+	return *p.NumberOfSuccessfulTestCases
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PipelineTrigger) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineTrigger) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (p *PipelineVariable) GetKey() string {
+	if p == nil || p.Key == nil {
+		return ""
+	}
+	return *p.Key
+}
+
+// GetSecured returns the Secured field if it's non-nil, zero value otherwise.
+func (p *PipelineVariable) GetSecured() bool {
+	if p == nil || p.Secured == nil {
+		return false
+	}
+	return *p.Secured
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineVariable) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (p *PipelineVariable) GetUUID() string {
+	if p == nil || p.UUID == nil {
+		return ""
+	}
+	return *p.UUID
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (p *PipelineVariable) GetValue() string {
+	if p == nil || p.Value == nil {
+		return ""
+	}
+	return *p.Value
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (p *PipelineVariableRequest) GetKey() string {
+	if p == nil || p.Key == nil {
+		return ""
+	}
+	return *p.Key
+}
+
+// GetSecured returns the Secured field if it's non-nil, zero value otherwise.
+func (p *PipelineVariableRequest) GetSecured() bool {
+	if p == nil || p.Secured == nil {
+		return false
+	}
+	return *p.Secured
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (p *PipelineVariableRequest) GetValue() string {
+	if p == nil || p.Value == nil {
+		return ""
+	}
+	return *p.Value
+}
+
+// HasValues checks if PipelineVariables has any Values.
+func (p *PipelineVariables) HasValues() bool {
+	if p == nil || p.Values == nil {
+		return false
+	}
+
+	if len(p.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// HasValues checks if PRActivities has any Values.
+func (p *PRActivities) HasValues() bool {
+	if p == nil || p.Values == nil {
+		return false
+	}
+
+	if len(p.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetApproval returns the Approval field.
+func (p *PRActivity) GetApproval() *PRApprovalActivity {
+	if p == nil {
+		return nil
+	}
+	return p.Approval
+}
+
+// GetChangesRequested returns the ChangesRequested field.
+func (p *PRActivity) GetChangesRequested() *PRApprovalActivity {
+	if p == nil {
+		return nil
+	}
+	return p.ChangesRequested
+}
+
+// GetPullRequest returns the PullRequest field.
+func (p *PRActivity) GetPullRequest() *PullRequest {
+	if p == nil {
+		return nil
+	}
+	return p.PullRequest
+}
+
+// GetUpdate returns the Update field.
+func (p *PRActivity) GetUpdate() *PRUpdateActivity {
+	if p == nil {
+		return nil
+	}
+	return p.Update
+}
+
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (p *PRApprovalActivity) GetDate() time.Time {
+	if p == nil || p.Date == nil {
+		return time.Time{}
+	}
+	return *p.Date
+}
+
+// GetPullRequest returns the PullRequest field.
+func (p *PRApprovalActivity) GetPullRequest() *PullRequest {
+	if p == nil {
+		return nil
+	}
+	return p.PullRequest
+}
+
+// GetUser returns the User field.
+func (p *PRApprovalActivity) GetUser() *User {
+	if p == nil {
+		return nil
+	}
+	return p.User
+}
+
+// GetDeleted returns the Deleted field if it's non-nil, zero value otherwise.
+func (p *PRComment) GetDeleted() bool {
+	if p == nil || p.Deleted == nil {
+		return false
+	}
+	return *p.Deleted
+}
+
+// GetInline returns the Inline field.
+func (p *PRComment) GetInline() *PRCommentInline {
+	if p == nil {
+		return nil
+	}
+	return p.Inline
+}
+
+// GetParent returns the Parent field.
+func (p *PRComment) GetParent() *Comment {
+	if p == nil {
+		return nil
+	}
+	return p.Parent
+}
+
+// GetPending returns the Pending field if it's non-nil, zero value otherwise.
+func (p *PRComment) GetPending() bool {
+	if p == nil || p.Pending == nil {
+		return false
+	}
+	return *p.Pending
+}
+
+// GetPullRequest returns the PullRequest field.
+func (p *PRComment) GetPullRequest() *PullRequest {
+	if p == nil {
+		return nil
+	}
+	return p.PullRequest
+}
+
+// GetResolution returns the Resolution field.
+func (p *PRComment) GetResolution() *PRCommentResolution {
+	if p == nil {
+		return nil
+	}
+	return p.Resolution
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (p *PRCommentInline) GetFrom() int64 {
+	if p == nil || p.From == nil {
+		return 0
+	}
+	return *p.From
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (p *PRCommentInline) GetPath() string {
+	if p == nil || p.Path == nil {
+		return ""
+	}
+	return *p.Path
+}
+
+// GetStartFrom returns the StartFrom field if it's non-nil, zero value otherwise.
+func (p *PRCommentInline) GetStartFrom() int64 {
+	if p == nil || p.StartFrom == nil {
+		return 0
+	}
+	return *p.StartFrom
+}
+
+// GetStartTo returns the StartTo field if it's non-nil, zero value otherwise.
+func (p *PRCommentInline) GetStartTo() int64 {
+	if p == nil || p.StartTo == nil {
+		return 0
+	}
+	return *p.StartTo
+}
+
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (p *PRCommentInline) GetTo() int64 {
+	if p == nil || p.To == nil {
+		return 0
+	}
+	return *p.To
+}
+
+// GetContent returns the Content field.
+func (p *PRCommentRequest) GetContent() *Content {
+	if p == nil {
+		return nil
+	}
+	return p.Content
+}
+
+// GetInline returns the Inline field.
+func (p *PRCommentRequest) GetInline() *PRCommentInline {
+	if p == nil {
+		return nil
+	}
+	return p.Inline
+}
+
+// GetParent returns the Parent field.
+func (p *PRCommentRequest) GetParent() *Comment {
+	if p == nil {
+		return nil
+	}
+	return p.Parent
+}
+
+// GetPending returns the Pending field if it's non-nil, zero value otherwise.
+func (p *PRCommentRequest) GetPending() bool {
+	if p == nil || p.Pending == nil {
+		return false
+	}
+	return *p.Pending
+}
+
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (p *PRCommentResolution) GetCreatedOn() time.Time {
+	if p == nil || p.CreatedOn == nil {
+		return time.Time{}
+	}
+	return *p.CreatedOn
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PRCommentResolution) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetUser returns the User field.
+func (p *PRCommentResolution) GetUser() *User {
+	if p == nil {
+		return nil
+	}
+	return p.User
+}
+
+// HasValues checks if PRComments has any Values.
+func (p *PRComments) HasValues() bool {
+	if p == nil || p.Values == nil {
+		return false
+	}
+
+	if len(p.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetComment returns the Comment field.
+func (p *PRCommentThread) GetComment() *PRComment {
+	if p == nil {
+		return nil
+	}
+	return p.Comment
+}
+
+// HasReplies checks if PRCommentThread has any Replies.
+func (p *PRCommentThread) HasReplies() bool {
+	if p == nil || p.Replies == nil {
+		return false
+	}
+
+	if len(p.Replies) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetMergeResult returns the MergeResult field.
+func (p *PRMergeTaskStatus) GetMergeResult() *PullRequest {
+	if p == nil {
+		return nil
+	}
+	return p.MergeResult
+}
+
+// GetTaskStatus returns the TaskStatus field if it's non-nil, zero value otherwise.
+func (p *PRMergeTaskStatus) GetTaskStatus() string {
+	if p == nil || p.TaskStatus == nil {
+		return ""
+	}
+	return *p.TaskStatus
+}
+
+// GetCloseSourceBranch returns the CloseSourceBranch field if it's non-nil, zero value otherwise.
+func (p *PRRequest) GetCloseSourceBranch() bool {
+	if p == nil || p.CloseSourceBranch == nil {
+		return false
+	}
+	return *p.CloseSourceBranch
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *PRRequest) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetDestination returns the Destination field.
+func (p *PRRequest) GetDestination() *PRRequestDestinationOpts {
+	if p == nil {
+		return nil
+	}
+	return p.Destination
+}
+
+// HasReviewers checks if PRRequest has any Reviewers.
+func (p *PRRequest) HasReviewers() bool {
+	if p == nil || p.Reviewers == nil {
+		return false
+	}
+
+	if len(p.Reviewers) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetSource returns the Source field.
+func (p *PRRequest) GetSource() *PRRequestSourceOpts {
+	if p == nil {
+		return nil
+	}
+	return p.Source
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *PRRequest) GetTitle() string {
+	if p == nil || p.Title == nil {
+		return ""
+	}
+	return *p.Title
+}
+
+// GetBranch returns the Branch field.
+func (p *PRRequestDestinationOpts) GetBranch() *Branch {
+	if p == nil {
+		return nil
+	}
+	return p.Branch
+}
+
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (p *PRRequestReviewerOpts) GetUUID() string {
+	if p == nil || p.UUID == nil {
+		return ""
+	}
+	return *p.UUID
+}
+
+// GetBranch returns the Branch field.
+func (p *PRRequestSourceOpts) GetBranch() *Branch {
+	if p == nil {
+		return nil
+	}
+	return p.Branch
+}
+
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (p *PRReview) GetDate() time.Time {
+	if p == nil || p.Date == nil {
+		return time.Time{}
+	}
+	return *p.Date
+}
+
+// GetUser returns the User field.
+func (p *PRReview) GetUser() *User {
+	if p == nil {
+		return nil
+	}
+	return p.User
+}
+
+// HasApproved checks if PRReviewSummary has any Approved.
+func (p *PRReviewSummary) HasApproved() bool {
+	if p == nil || p.Approved == nil {
+		return false
+	}
+
+	if len(p.Approved) == 0 {
+		return false
+	}
+	return true
+}
+
+// HasChangesRequested checks if PRReviewSummary has any ChangesRequested.
+func (p *PRReviewSummary) HasChangesRequested() bool {
+	if p == nil || p.ChangesRequested == nil {
+		return false
+	}
+
+	if len(p.ChangesRequested) == 0 {
+		return false
+	}
+	return true
+}
+
+// HasMissingDefaultReviewers checks if PRReviewSummary has any MissingDefaultReviewers.
+func (p *PRReviewSummary) HasMissingDefaultReviewers() bool {
+	if p == nil || p.MissingDefaultReviewers == nil {
+		return false
+	}
+
+	if len(p.MissingDefaultReviewers) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetComment returns the Comment field.
+func (p *PRTask) GetComment() *PRComment {
+	if p == nil {
+		return nil
+	}
+	return p.Comment
+}
+
+// GetContent returns the Content field.
+func (p *PRTask) GetContent() *Content {
+	if p == nil {
+		return nil
+	}
+	return p.Content
+}
+
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (p *PRTask) GetCreatedOn() time.Time {
+	if p == nil || p.CreatedOn == nil {
+		return time.Time{}
+	}
+	return *p.CreatedOn
+}
+
+// GetCreator returns the Creator field.
+func (p *PRTask) GetCreator() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Creator
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PRTask) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetLinks returns the Links field.
+func (p *PRTask) GetLinks() *PRTaskLinks {
+	if p == nil {
+		return nil
+	}
+	return p.Links
+}
+
+// GetPending returns the Pending field if it's non-nil, zero value otherwise.
+func (p *PRTask) GetPending() bool {
+	if p == nil || p.Pending == nil {
+		return false
+	}
+	return *p.Pending
+}
+
+// GetResolvedBy returns the ResolvedBy field.
+func (p *PRTask) GetResolvedBy() *User {
+	if p == nil {
+		return nil
+	}
+	return p.ResolvedBy
+}
+
+// GetResolvedOn returns the ResolvedOn field if it's non-nil, zero value otherwise.
+func (p *PRTask) GetResolvedOn() time.Time {
+	if p == nil || p.ResolvedOn == nil {
+		return time.Time{}
+	}
+	return *p.ResolvedOn
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PRTask) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+// GetUpdatedOn returns the UpdatedOn field if it's non-nil, zero value otherwise.
+func (p *PRTask) GetUpdatedOn() time.Time {
+	if p == nil || p.UpdatedOn == nil {
+		return time.Time{}
+	}
+	return *p.UpdatedOn
+}
+
+// GetHTML returns the HTML field.
+func (p *PRTaskLinks) GetHTML() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.HTML
+}
+
+// GetSelf returns the Self field.
+func (p *PRTaskLinks) GetSelf() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.Self
+}
+
+// GetComment returns the Comment field.
+func (p *PRTaskRequest) GetComment() *Comment {
+	if p == nil {
+		return nil
+	}
+	return p.Comment
+}
+
+// GetContent returns the Content field.
+func (p *PRTaskRequest) GetContent() *Content {
+	if p == nil {
+		return nil
+	}
+	return p.Content
+}
+
+// GetPending returns the Pending field if it's non-nil, zero value otherwise.
+func (p *PRTaskRequest) GetPending() bool {
+	if p == nil || p.Pending == nil {
+		return false
+	}
+	return *p.Pending
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PRTaskRequest) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+// HasValues checks if PRTasks has any Values.
+func (p *PRTasks) HasValues() bool {
+	if p == nil || p.Values == nil {
+		return false
+	}
+
+	if len(p.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetAuthor returns the Author field.
+func (p *PRUpdateActivity) GetAuthor() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Author
+}
+
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (p *PRUpdateActivity) GetDate() time.Time {
+	if p == nil || p.Date == nil {
+		return time.Time{}
+	}
+	return *p.Date
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *PRUpdateActivity) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetDestination returns the Destination field.
+func (p *PRUpdateActivity) GetDestination() *PullRequestBranch {
+	if p == nil {
+		return nil
+	}
+	return p.Destination
+}
+
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (p *PRUpdateActivity) GetReason() string {
+	if p == nil || p.Reason == nil {
+		return ""
+	}
+	return *p.Reason
+}
+
+// GetSource returns the Source field.
+func (p *PRUpdateActivity) GetSource() *PullRequestBranch {
+	if p == nil {
+		return nil
+	}
+	return p.Source
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PRUpdateActivity) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *PRUpdateActivity) GetTitle() string {
+	if p == nil || p.Title == nil {
+		return ""
+	}
+	return *p.Title
+}
+
+// GetAuthor returns the Author field.
+func (p *PullRequest) GetAuthor() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Author
+}
+
+// GetBody returns the Body field.
+func (p *PullRequest) GetBody() *PullRequestBody {
+	if p == nil {
+		return nil
+	}
+	return p.Body
+}
+
+// GetClosedBy returns the ClosedBy field.
+func (p *PullRequest) GetClosedBy() *User {
+	if p == nil {
+		return nil
+	}
+	return p.ClosedBy
+}
+
+// GetCloseSourceBranch returns the CloseSourceBranch field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCloseSourceBranch() bool {
+	if p == nil || p.CloseSourceBranch == nil {
+		return false
+	}
+	return *p.CloseSourceBranch
+}
+
+// GetCommentCount returns the CommentCount field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCommentCount() int64 {
+	if p == nil || p.CommentCount == nil {
+		return 0
+	}
+	return *p.CommentCount
+}
+
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCreatedOn() time.Time {
+	if p == nil || p.CreatedOn == nil {
+		return time.Time{}
+	}
+	return *p.CreatedOn
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetDestination returns the Destination field.
+func (p *PullRequest) GetDestination() *PullRequestBranch {
+	if p == nil {
+		return nil
+	}
+	return p.Destination
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetLinks returns the Links field.
+func (p *PullRequest) GetLinks() *PullRequestLinks {
+	if p == nil {
+		return nil
+	}
+	return p.Links
+}
+
+// GetMergeCommit returns the MergeCommit field.
+func (p *PullRequest) GetMergeCommit() *PullRequestMergeCommit {
+	if p == nil {
+		return nil
+	}
+	return p.MergeCommit
+}
+
+// HasParticipants checks if PullRequest has any Participants.
+func (p *PullRequest) HasParticipants() bool {
+	if p == nil || p.Participants == nil {
+		return false
+	}
+
+	if len(p.Participants) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetReason() string {
+	if p == nil || p.Reason == nil {
+		return ""
+	}
+	return *p.Reason
+}
+
+// HasReviewers checks if PullRequest has any Reviewers.
+func (p *PullRequest) HasReviewers() bool {
+	if p == nil || p.Reviewers == nil {
+		return false
+	}
+
+	if len(p.Reviewers) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetSource returns the Source field.
+func (p *PullRequest) GetSource() *PullRequestBranch {
+	if p == nil {
+		return nil
+	}
+	return p.Source
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+// GetSummary returns the Summary field.
+func (p *PullRequest) GetSummary() *Content {
+	if p == nil {
+		return nil
+	}
+	return p.Summary
+}
+
+// GetTaskCount returns the TaskCount field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetTaskCount() int64 {
+	if p == nil || p.TaskCount == nil {
+		return 0
+	}
+	return *p.TaskCount
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetTitle() string {
+	if p == nil || p.Title == nil {
+		return ""
+	}
+	return *p.Title
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetUpdatedOn returns the UpdatedOn field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetUpdatedOn() string {
+	if p == nil || p.UpdatedOn == nil {
 		return ""
 	}
-	return *p.Reason
+	return *p.UpdatedOn
+}
+
+// GetDescription returns the Description field.
+func (p *PullRequestBody) GetDescription() *Content {
+	if p == nil {
+		return nil
+	}
+	return p.Description
+}
+
+// GetTitle returns the Title field.
+func (p *PullRequestBody) GetTitle() *Content {
+	if p == nil {
+		return nil
+	}
+	return p.Title
+}
+
+// GetBranch returns the Branch field.
+func (p *PullRequestBranch) GetBranch() *Branch {
+	if p == nil {
+		return nil
+	}
+	return p.Branch
+}
+
+// GetCommit returns the Commit field.
+func (p *PullRequestBranch) GetCommit() *Commit {
+	if p == nil {
+		return nil
+	}
+	return p.Commit
 }
 
-// GetSource returns the Source field.
-func (p *PRUpdateActivity) GetSource() *PullRequestBranch {
+// GetRepository returns the Repository field.
+func (p *PullRequestBranch) GetRepository() *Repository {
 	if p == nil {
 		return nil
 	}
-	return p.Source
+	return p.Repository
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *PRUpdateActivity) GetState() string {
-	if p == nil || p.State == nil {
-		return ""
+// GetActivity returns the Activity field.
+func (p *PullRequestLinks) GetActivity() *Link {
+	if p == nil {
+		return nil
 	}
-	return *p.State
+	return p.Activity
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (p *PRUpdateActivity) GetTitle() string {
-	if p == nil || p.Title == nil {
-		return ""
+// GetApprove returns the Approve field.
+func (p *PullRequestLinks) GetApprove() *Link {
+	if p == nil {
+		return nil
 	}
-	return *p.Title
+	return p.Approve
 }
 
-// GetAuthor returns the Author field.
-func (p *PullRequest) GetAuthor() *User {
+// GetComments returns the Comments field.
+func (p *PullRequestLinks) GetComments() *Link {
 	if p == nil {
 		return nil
 	}
-	return p.Author
+	return p.Comments
 }
 
-// GetBody returns the Body field.
-func (p *PullRequest) GetBody() *PullRequestBody {
+// GetCommits returns the Commits field.
+func (p *PullRequestLinks) GetCommits() *Link {
 	if p == nil {
 		return nil
 	}
-	return p.Body
+	return p.Commits
 }
 
-// GetClosedBy returns the ClosedBy field.
-func (p *PullRequest) GetClosedBy() *User {
+// GetDecline returns the Decline field.
+func (p *PullRequestLinks) GetDecline() *Link {
 	if p == nil {
 		return nil
 	}
-	return p.ClosedBy
+	return p.Decline
 }
 
-// GetCloseSourceBranch returns the CloseSourceBranch field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCloseSourceBranch() bool {
-	if p == nil || p.CloseSourceBranch == nil {
+// GetDiff returns the Diff field.
+func (p *PullRequestLinks) GetDiff() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.Diff
+}
+
+// GetHTML returns the HTML field.
+func (p *PullRequestLinks) GetHTML() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.HTML
+}
+
+// GetMerge returns the Merge field.
+func (p *PullRequestLinks) GetMerge() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.Merge
+}
+
+// GetSelf returns the Self field.
+func (p *PullRequestLinks) GetSelf() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.Self
+}
+
+// GetStatuses returns the Statuses field.
+func (p *PullRequestLinks) GetStatuses() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.Statuses
+}
+
+// HasState checks if PullRequestListOpts has any State.
+func (p *PullRequestListOpts) HasState() bool {
+	if p == nil || p.State == nil {
 		return false
 	}
-	return *p.CloseSourceBranch
+
+	if len(p.State) == 0 {
+		return false
+	}
+	return true
 }
 
-// GetCommentCount returns the CommentCount field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCommentCount() int64 {
-	if p == nil || p.CommentCount == nil {
-		return 0
+// GetHash returns the Hash field if it's non-nil, zero value otherwise.
+func (p *PullRequestMergeCommit) GetHash() string {
+	if p == nil || p.Hash == nil {
+		return ""
 	}
-	return *p.CommentCount
+	return *p.Hash
 }
 
-// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCreatedOn() time.Time {
-	if p == nil || p.CreatedOn == nil {
+// HasValues checks if PullRequests has any Values.
+func (p *PullRequests) HasValues() bool {
+	if p == nil || p.Values == nil {
+		return false
+	}
+
+	if len(p.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (r *Ref) GetDate() time.Time {
+	if r == nil || r.Date == nil {
 		return time.Time{}
 	}
-	return *p.CreatedOn
+	return *r.Date
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetDescription() string {
-	if p == nil || p.Description == nil {
+// GetDefaultMergeStrategy returns the DefaultMergeStrategy field if it's non-nil, zero value otherwise.
+func (r *Ref) GetDefaultMergeStrategy() string {
+	if r == nil || r.DefaultMergeStrategy == nil {
 		return ""
 	}
-	return *p.Description
+	return *r.DefaultMergeStrategy
 }
 
-// GetDestination returns the Destination field.
-func (p *PullRequest) GetDestination() *PullRequestBranch {
-	if p == nil {
+// HasHeads checks if Ref has any Heads.
+func (r *Ref) HasHeads() bool {
+	if r == nil || r.Heads == nil {
+		return false
+	}
+
+	if len(r.Heads) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetLinks returns the Links field.
+func (r *Ref) GetLinks() *RefLinks {
+	if r == nil {
 		return nil
 	}
-	return p.Destination
+	return r.Links
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// HasMergeStrategies checks if Ref has any MergeStrategies.
+func (r *Ref) HasMergeStrategies() bool {
+	if r == nil || r.MergeStrategies == nil {
+		return false
 	}
-	return *p.ID
+
+	if len(r.MergeStrategies) == 0 {
+		return false
+	}
+	return true
 }
 
-// GetLinks returns the Links field.
-func (p *PullRequest) GetLinks() *PullRequestLinks {
-	if p == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (r *Ref) GetMessage() string {
+	if r == nil || r.Message == nil {
+		return ""
+	}
+	return *r.Message
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *Ref) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetTarget returns the Target field.
+func (r *Ref) GetTarget() *Commit {
+	if r == nil {
 		return nil
 	}
-	return p.Links
+	return r.Target
 }
 
-// GetMergeCommit returns the MergeCommit field.
-func (p *PullRequest) GetMergeCommit() *PullRequestMergeCommit {
-	if p == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *Ref) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
+	}
+	return *r.Type
+}
+
+// GetCommits returns the Commits field.
+func (r *RefLinks) GetCommits() *Link {
+	if r == nil {
 		return nil
 	}
-	return p.MergeCommit
+	return r.Commits
+}
+
+// GetHTML returns the HTML field.
+func (r *RefLinks) GetHTML() *Link {
+	if r == nil {
+		return nil
+	}
+	return r.HTML
+}
+
+// GetSelf returns the Self field.
+func (r *RefLinks) GetSelf() *Link {
+	if r == nil {
+		return nil
+	}
+	return r.Self
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RefRequest) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// HasValues checks if Refs has any Values.
+func (r *Refs) HasValues() bool {
+	if r == nil || r.Values == nil {
+		return false
+	}
+
+	if len(r.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (r *Report) GetCreatedOn() time.Time {
+	if r == nil || r.CreatedOn == nil {
+		return time.Time{}
+	}
+	return *r.CreatedOn
 }
 
-// HasParticipants checks if PullRequest has any Participants.
-func (p *PullRequest) HasParticipants() bool {
-	if p == nil || p.Participants == nil {
+// HasData checks if Report has any Data.
+func (r *Report) HasData() bool {
+	if r == nil || r.Data == nil {
 		return false
 	}
 
-	if len(p.Participants) == 0 {
+	if len(r.Data) == 0 {
 		return false
 	}
 	return true
 }
 
-// GetReason returns the Reason field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetReason() string {
-	if p == nil || p.Reason == nil {
+// GetDetails returns the Details field if it's non-nil, zero value otherwise.
+func (r *Report) GetDetails() string {
+	if r == nil || r.Details == nil {
 		return ""
 	}
-	return *p.Reason
+	return *r.Details
 }
 
-// HasReviewers checks if PullRequest has any Reviewers.
-func (p *PullRequest) HasReviewers() bool {
-	if p == nil || p.Reviewers == nil {
-		return false
+// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
+func (r *Report) GetExternalID() string {
+	if r == nil || r.ExternalID == nil {
+		return ""
 	}
+	return *r.ExternalID
+}
 
-	if len(p.Reviewers) == 0 {
-		return false
+// GetLink returns the Link field if it's non-nil, zero value otherwise.
+func (r *Report) GetLink() string {
+	if r == nil || r.Link == nil {
+		return ""
 	}
-	return true
+	return *r.Link
 }
 
-// GetSource returns the Source field.
-func (p *PullRequest) GetSource() *PullRequestBranch {
-	if p == nil {
-		return nil
+// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
+func (r *Report) GetLogoURL() string {
+	if r == nil || r.LogoURL == nil {
+		return ""
 	}
-	return p.Source
+	return *r.LogoURL
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetState() string {
-	if p == nil || p.State == nil {
+// GetRemoteLinkEnabled returns the RemoteLinkEnabled field if it's non-nil, zero value otherwise.
+func (r *Report) GetRemoteLinkEnabled() bool {
+	if r == nil || r.RemoteLinkEnabled == nil {
+		return false
+	}
+	return *r.RemoteLinkEnabled
+}
+
+// GetReporter returns the Reporter field if it's non-nil, zero value otherwise.
+func (r *Report) GetReporter() string {
+	if r == nil || r.Reporter == nil {
 		return ""
 	}
-	return *p.State
+	return *r.Reporter
 }
 
-// GetSummary returns the Summary field.
-func (p *PullRequest) GetSummary() *Content {
-	if p == nil {
-		return nil
+// GetReportType returns the ReportType field if it's non-nil, zero value otherwise.
+func (r *Report) GetReportType() string {
+	if r == nil || r.ReportType == nil {
+		return ""
 	}
-	return p.Summary
+	return *r.ReportType
 }
 
-// GetTaskCount returns the TaskCount field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetTaskCount() int64 {
-	if p == nil || p.TaskCount == nil {
-		return 0
+// GetResult returns the Result field if it's non-nil, zero value otherwise.
+func (r *Report) GetResult() string {
+	if r == nil || r.Result == nil {
+		return ""
 	}
-	return *p.TaskCount
+	return *r.Result
 }
 
 // GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetTitle() string {
-	if p == nil || p.Title == nil {
+func (r *Report) GetTitle() string {
+	if r == nil || r.Title == nil {
 		return ""
 	}
-	return *p.Title
+	return *r.Title
 }
 
 // GetType returns the Type field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetType() string {
-	if p == nil || p.Type == nil {
+func (r *Report) GetType() string {
+	if r == nil || r.Type == nil {
 		return ""
 	}
-	return *p.Type
+	return *r.Type
 }
 
 // GetUpdatedOn returns the UpdatedOn field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetUpdatedOn() string {
-	if p == nil || p.UpdatedOn == nil {
+func (r *Report) GetUpdatedOn() time.Time {
+	if r == nil || r.UpdatedOn == nil {
+		return time.Time{}
+	}
+	return *r.UpdatedOn
+}
+
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (r *Report) GetUUID() string {
+	if r == nil || r.UUID == nil {
 		return ""
 	}
-	return *p.UpdatedOn
+	return *r.UUID
 }
 
-// GetDescription returns the Description field.
-func (p *PullRequestBody) GetDescription() *Content {
-	if p == nil {
-		return nil
+// GetAnnotationType returns the AnnotationType field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotation) GetAnnotationType() string {
+	if r == nil || r.AnnotationType == nil {
+		return ""
 	}
-	return p.Description
+	return *r.AnnotationType
 }
 
-// GetTitle returns the Title field.
-func (p *PullRequestBody) GetTitle() *Content {
-	if p == nil {
-		return nil
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotation) GetCreatedOn() time.Time {
+	if r == nil || r.CreatedOn == nil {
+		return time.Time{}
 	}
-	return p.Title
+	return *r.CreatedOn
 }
 
-// GetBranch returns the Branch field.
-func (p *PullRequestBranch) GetBranch() *Branch {
-	if p == nil {
-		return nil
+// GetDetails returns the Details field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotation) GetDetails() string {
+	if r == nil || r.Details == nil {
+		return ""
 	}
-	return p.Branch
+	return *r.Details
 }
 
-// GetCommit returns the Commit field.
-func (p *PullRequestBranch) GetCommit() *Commit {
-	if p == nil {
-		return nil
+// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotation) GetExternalID() string {
+	if r == nil || r.ExternalID == nil {
+		return ""
 	}
-	return p.Commit
+	return *r.ExternalID
 }
 
-// GetRepository returns the Repository field.
-func (p *PullRequestBranch) GetRepository() *Repository {
-	if p == nil {
-		return nil
+// GetLine returns the Line field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotation) GetLine() int64 {
+	if r == nil || r.Line == nil {
+		return 0
 	}
-	return p.Repository
+	return *r.Line
 }
 
-// GetActivity returns the Activity field.
-func (p *PullRequestLinks) GetActivity() *Link {
-	if p == nil {
-		return nil
+// GetLink returns the Link field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotation) GetLink() string {
+	if r == nil || r.Link == nil {
+		return ""
 	}
-	return p.Activity
+	return *r.Link
 }
 
-// GetApprove returns the Approve field.
-func (p *PullRequestLinks) GetApprove() *Link {
-	if p == nil {
-		return nil
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotation) GetPath() string {
+	if r == nil || r.Path == nil {
+		return ""
 	}
-	return p.Approve
+	return *r.Path
 }
 
-// GetComments returns the Comments field.
-func (p *PullRequestLinks) GetComments() *Link {
-	if p == nil {
-		return nil
+// GetResult returns the Result field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotation) GetResult() string {
+	if r == nil || r.Result == nil {
+		return ""
 	}
-	return p.Comments
+	return *r.Result
 }
 
-// GetCommits returns the Commits field.
-func (p *PullRequestLinks) GetCommits() *Link {
-	if p == nil {
-		return nil
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotation) GetSeverity() string {
+	if r == nil || r.Severity == nil {
+		return ""
 	}
-	return p.Commits
+	return *r.Severity
 }
 
-// GetDecline returns the Decline field.
-func (p *PullRequestLinks) GetDecline() *Link {
-	if p == nil {
-		return nil
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotation) GetSummary() string {
+	if r == nil || r.Summary == nil {
+		return ""
 	}
-	return p.Decline
+	return *r.Summary
 }
 
-// GetDiff returns the Diff field.
-func (p *PullRequestLinks) GetDiff() *Link {
-	if p == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotation) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
 	}
-	return p.Diff
+	return *r.Type
 }
 
-// GetHTML returns the HTML field.
-func (p *PullRequestLinks) GetHTML() *Link {
-	if p == nil {
-		return nil
+// GetUpdatedOn returns the UpdatedOn field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotation) GetUpdatedOn() time.Time {
+	if r == nil || r.UpdatedOn == nil {
+		return time.Time{}
 	}
-	return p.HTML
+	return *r.UpdatedOn
 }
 
-// GetMerge returns the Merge field.
-func (p *PullRequestLinks) GetMerge() *Link {
-	if p == nil {
-		return nil
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotation) GetUUID() string {
+	if r == nil || r.UUID == nil {
+		return ""
 	}
-	return p.Merge
+	return *r.UUID
 }
 
-// GetSelf returns the Self field.
-func (p *PullRequestLinks) GetSelf() *Link {
-	if p == nil {
-		return nil
+// GetAnnotationType returns the AnnotationType field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotationRequest) GetAnnotationType() string {
+	if r == nil || r.AnnotationType == nil {
+		return ""
 	}
-	return p.Self
+	return *r.AnnotationType
 }
 
-// GetStatuses returns the Statuses field.
-func (p *PullRequestLinks) GetStatuses() *Link {
-	if p == nil {
-		return nil
+// GetDetails returns the Details field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotationRequest) GetDetails() string {
+	if r == nil || r.Details == nil {
+		return ""
 	}
-	return p.Statuses
+	return *r.Details
 }
 
-// HasState checks if PullRequestListOpts has any State.
-func (p *PullRequestListOpts) HasState() bool {
-	if p == nil || p.State == nil {
-		return false
+// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotationRequest) GetExternalID() string {
+	if r == nil || r.ExternalID == nil {
+		return ""
 	}
+	return *r.ExternalID
+}
 
-	if len(p.State) == 0 {
-		return false
+// GetLine returns the Line field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotationRequest) GetLine() int64 {
+	if r == nil || r.Line == nil {
+		return 0
 	}
-	return true
+	return *r.Line
 }
 
-// GetHash returns the Hash field if it's non-nil, zero value otherwise.
-func (p *PullRequestMergeCommit) GetHash() string {
-	if p == nil || p.Hash == nil {
+// GetLink returns the Link field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotationRequest) GetLink() string {
+	if r == nil || r.Link == nil {
 		return ""
 	}
-	return *p.Hash
+	return *r.Link
 }
 
-// HasValues checks if PullRequests has any Values.
-func (p *PullRequests) HasValues() bool {
-	if p == nil || p.Values == nil {
-		return false
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotationRequest) GetPath() string {
+	if r == nil || r.Path == nil {
+		return ""
 	}
+	return *r.Path
+}
 
-	if len(p.Values) == 0 {
-		return false
+// GetResult returns the Result field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotationRequest) GetResult() string {
+	if r == nil || r.Result == nil {
+		return ""
 	}
-	return true
+	return *r.Result
 }
 
-// GetDate returns the Date field if it's non-nil, zero value otherwise.
-func (r *Ref) GetDate() time.Time {
-	if r == nil || r.Date == nil {
-		return time.Time{}
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotationRequest) GetSeverity() string {
+	if r == nil || r.Severity == nil {
+		return ""
 	}
-	return *r.Date
+	return *r.Severity
 }
 
-// GetDefaultMergeStrategy returns the DefaultMergeStrategy field if it's non-nil, zero value otherwise.
-func (r *Ref) GetDefaultMergeStrategy() string {
-	if r == nil || r.DefaultMergeStrategy == nil {
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (r *ReportAnnotationRequest) GetSummary() string {
+	if r == nil || r.Summary == nil {
 		return ""
 	}
-	return *r.DefaultMergeStrategy
+	return *r.Summary
 }
 
-// HasHeads checks if Ref has any Heads.
-func (r *Ref) HasHeads() bool {
-	if r == nil || r.Heads == nil {
+// HasValues checks if ReportAnnotations has any Values.
+func (r *ReportAnnotations) HasValues() bool {
+	if r == nil || r.Values == nil {
 		return false
 	}
 
-	if len(r.Heads) == 0 {
+	if len(r.Values) == 0 {
 		return false
 	}
 	return true
 }
 
-// GetLinks returns the Links field.
-func (r *Ref) GetLinks() *RefLinks {
-	if r == nil {
-		return nil
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (r *ReportData) GetTitle() string {
+	if r == nil || r.Title == nil {
+		return ""
 	}
-	return r.Links
+	return *r.Title
 }
 
-// HasMergeStrategies checks if Ref has any MergeStrategies.
-func (r *Ref) HasMergeStrategies() bool {
-	if r == nil || r.MergeStrategies == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *ReportData) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
+	}
+	return *r.Type
+}
+
+// GetHref returns the Href field if it's non-nil, zero value otherwise.
+func (r *ReportDataLink) GetHref() string {
+	if r == nil || r.Href == nil {
+		return ""
+	}
+	return *r.Href
+}
+
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (r *ReportDataLink) GetText() string {
+	if r == nil || r.Text == nil {
+		return ""
+	}
+	return *r.Text
+}
+
+// HasData checks if ReportRequest has any Data.
+func (r *ReportRequest) HasData() bool {
+	if r == nil || r.Data == nil {
 		return false
 	}
 
-	if len(r.MergeStrategies) == 0 {
+	if len(r.Data) == 0 {
 		return false
 	}
 	return true
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (r *Ref) GetMessage() string {
-	if r == nil || r.Message == nil {
+// GetDetails returns the Details field if it's non-nil, zero value otherwise.
+func (r *ReportRequest) GetDetails() string {
+	if r == nil || r.Details == nil {
 		return ""
 	}
-	return *r.Message
+	return *r.Details
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *Ref) GetName() string {
-	if r == nil || r.Name == nil {
+// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
+func (r *ReportRequest) GetExternalID() string {
+	if r == nil || r.ExternalID == nil {
 		return ""
 	}
-	return *r.Name
+	return *r.ExternalID
 }
 
-// GetTarget returns the Target field.
-func (r *Ref) GetTarget() *Commit {
-	if r == nil {
-		return nil
+// GetLink returns the Link field if it's non-nil, zero value otherwise.
+func (r *ReportRequest) GetLink() string {
+	if r == nil || r.Link == nil {
+		return ""
 	}
-	return r.Target
+	return *r.Link
+}
+
+// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
+func (r *ReportRequest) GetLogoURL() string {
+	if r == nil || r.LogoURL == nil {
+		return ""
+	}
+	return *r.LogoURL
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (r *Ref) GetType() string {
-	if r == nil || r.Type == nil {
-		return ""
+// GetRemoteLinkEnabled returns the RemoteLinkEnabled field if it's non-nil, zero value otherwise.
+func (r *ReportRequest) GetRemoteLinkEnabled() bool {
+	if r == nil || r.RemoteLinkEnabled == nil {
+		return false
 	}
-	return *r.Type
+	return *r.RemoteLinkEnabled
 }
 
-// GetCommits returns the Commits field.
-func (r *RefLinks) GetCommits() *Link {
-	if r == nil {
-		return nil
+// GetReporter returns the Reporter field if it's non-nil, zero value otherwise.
+func (r *ReportRequest) GetReporter() string {
+	if r == nil || r.Reporter == nil {
+		return ""
 	}
-	return r.Commits
+	return *r.Reporter
 }
 
-// GetHTML returns the HTML field.
-func (r *RefLinks) GetHTML() *Link {
-	if r == nil {
-		return nil
+// GetReportType returns the ReportType field if it's non-nil, zero value otherwise.
+func (r *ReportRequest) GetReportType() string {
+	if r == nil || r.ReportType == nil {
+		return ""
 	}
-	return r.HTML
+	return *r.ReportType
 }
 
-// GetSelf returns the Self field.
-func (r *RefLinks) GetSelf() *Link {
-	if r == nil {
-		return nil
+// GetResult returns the Result field if it's non-nil, zero value otherwise.
+func (r *ReportRequest) GetResult() string {
+	if r == nil || r.Result == nil {
+		return ""
 	}
-	return r.Self
+	return *r.Result
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RefRequest) GetName() string {
-	if r == nil || r.Name == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (r *ReportRequest) GetTitle() string {
+	if r == nil || r.Title == nil {
 		return ""
 	}
-	return *r.Name
+	return *r.Title
 }
 
-// HasValues checks if Refs has any Values.
-func (r *Refs) HasValues() bool {
+// HasValues checks if Reports has any Values.
+func (r *Reports) HasValues() bool {
 	if r == nil || r.Values == nil {
 		return false
 	}
@@ -3061,16 +5493,20 @@ func (r *RepositoryHook) HasEvents() bool {
 	return true
 }
 
-// HasSubjectType checks if RepositoryHook has any SubjectType.
-func (r *RepositoryHook) HasSubjectType() bool {
-	if r == nil || r.SubjectType == nil {
+// GetSecretSet returns the SecretSet field if it's non-nil, zero value otherwise.
+func (r *RepositoryHook) GetSecretSet() bool {
+	if r == nil || r.SecretSet == nil {
 		return false
 	}
+	return *r.SecretSet
+}
 
-	if len(r.SubjectType) == 0 {
-		return false
+// GetSubjectType returns the SubjectType field if it's non-nil, zero value otherwise.
+func (r *RepositoryHook) GetSubjectType() string {
+	if r == nil || r.SubjectType == nil {
+		return ""
 	}
-	return true
+	return *r.SubjectType
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
@@ -3117,6 +5553,14 @@ func (r *RepositoryHookRequest) HasEvents() bool {
 	return true
 }
 
+// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
+func (r *RepositoryHookRequest) GetSecret() string {
+	if r == nil || r.Secret == nil {
+		return ""
+	}
+	return *r.Secret
+}
+
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
 func (r *RepositoryHookRequest) GetURL() string {
 	if r == nil || r.URL == nil {
@@ -3373,12 +5817,16 @@ func (s *SearchCodeResults) GetQuerySubstituted() bool {
 	return *s.QuerySubstituted
 }
 
-// GetValues returns the Values field.
-func (s *SearchCodeResults) GetValues() *SearchCodeResult {
-	if s == nil {
-		return nil
+// HasValues checks if SearchCodeResults has any Values.
+func (s *SearchCodeResults) HasValues() bool {
+	if s == nil || s.Values == nil {
+		return false
+	}
+
+	if len(s.Values) == 0 {
+		return false
 	}
-	return s.Values
+	return true
 }
 
 // GetLines returns the Lines field.
@@ -3425,6 +5873,42 @@ func (s *SearchMatch) GetText() string {
 	return *s.Text
 }
 
+// GetAuthor returns the Author field if it's non-nil, zero value otherwise.
+func (s *SRCCommitRequest) GetAuthor() string {
+	if s == nil || s.Author == nil {
+		return ""
+	}
+	return *s.Author
+}
+
+// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
+func (s *SRCCommitRequest) GetBranch() string {
+	if s == nil || s.Branch == nil {
+		return ""
+	}
+	return *s.Branch
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (s *SRCCommitRequest) GetMessage() string {
+	if s == nil || s.Message == nil {
+		return ""
+	}
+	return *s.Message
+}
+
+// HasParents checks if SRCCommitRequest has any Parents.
+func (s *SRCCommitRequest) HasParents() bool {
+	if s == nil || s.Parents == nil {
+		return false
+	}
+
+	if len(s.Parents) == 0 {
+		return false
+	}
+	return true
+}
+
 // HasAttributes checks if SRCMetadata has any Attributes.
 func (s *SRCMetadata) HasAttributes() bool {
 	if s == nil || s.Attributes == nil {
@@ -3445,6 +5929,14 @@ func (s *SRCMetadata) GetCommit() *Commit {
 	return s.Commit
 }
 
+// GetEscapedPath returns the EscapedPath field if it's non-nil, zero value otherwise.
+func (s *SRCMetadata) GetEscapedPath() string {
+	if s == nil || s.EscapedPath == nil {
+		return ""
+	}
+	return *s.EscapedPath
+}
+
 // GetLinks returns the Links field.
 func (s *SRCMetadata) GetLinks() *FileHistoryLinks {
 	if s == nil {
@@ -4452,3 +6944,299 @@ func (v *Versions) HasValues() bool {
 	}
 	return true
 }
+
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (w *Workspace) GetCreatedOn() time.Time {
+	if w == nil || w.CreatedOn == nil {
+		return time.Time{}
+	}
+	return *w.CreatedOn
+}
+
+// GetIsPrivate returns the IsPrivate field if it's non-nil, zero value otherwise.
+func (w *Workspace) GetIsPrivate() bool {
+	if w == nil || w.IsPrivate == nil {
+		return false
+	}
+	return *w.IsPrivate
+}
+
+// GetLinks returns the Links field.
+func (w *Workspace) GetLinks() *WorkspaceLinks {
+	if w == nil {
+		return nil
+	}
+	return w.Links
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (w *Workspace) GetName() string {
+	if w == nil || w.Name == nil {
+		return ""
+	}
+	return *w.Name
+}
+
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (w *Workspace) GetSlug() string {
+	if w == nil || w.Slug == nil {
+		return ""
+	}
+	return *w.Slug
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (w *Workspace) GetType() string {
+	if w == nil || w.Type == nil {
+		return ""
+	}
+	return *w.Type
+}
+
+// GetUpdatedOn returns the UpdatedOn field if it's non-nil, zero value otherwise.
+func (w *Workspace) GetUpdatedOn() time.Time {
+	if w == nil || w.UpdatedOn == nil {
+		return time.Time{}
+	}
+	return *w.UpdatedOn
+}
+
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (w *Workspace) GetUUID() string {
+	if w == nil || w.UUID == nil {
+		return ""
+	}
+	return *w.UUID
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (w *WorkspaceHook) GetActive() bool {
+	if w == nil || w.Active == nil {
+		return false
+	}
+	return *w.Active
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (w *WorkspaceHook) GetCreatedAt() time.Time {
+	if w == nil || w.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *w.CreatedAt
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (w *WorkspaceHook) GetDescription() string {
+	if w == nil || w.Description == nil {
+		return ""
+	}
+	return *w.Description
+}
+
+// HasEvents checks if WorkspaceHook has any Events.
+func (w *WorkspaceHook) HasEvents() bool {
+	if w == nil || w.Events == nil {
+		return false
+	}
+
+	if len(w.Events) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetSecretSet returns the SecretSet field if it's non-nil, zero value otherwise.
+func (w *WorkspaceHook) GetSecretSet() bool {
+	if w == nil || w.SecretSet == nil {
+		return false
+	}
+	return *w.SecretSet
+}
+
+// GetSubject returns the Subject field.
+func (w *WorkspaceHook) GetSubject() *Workspace {
+	if w == nil {
+		return nil
+	}
+	return w.Subject
+}
+
+// GetSubjectType returns the SubjectType field if it's non-nil, zero value otherwise.
+func (w *WorkspaceHook) GetSubjectType() string {
+	if w == nil || w.SubjectType == nil {
+		return ""
+	}
+	return *w.SubjectType
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (w *WorkspaceHook) GetURL() string {
+	if w == nil || w.URL == nil {
+		return ""
+	}
+	return *w.URL
+}
+
+// GetUUID returns the UUID field if it's non-nil, zero value otherwise.
+func (w *WorkspaceHook) GetUUID() string {
+	if w == nil || w.UUID == nil {
+		return ""
+	}
+	return *w.UUID
+}
+
+// HasValues checks if WorkspaceHooks has any Values.
+func (w *WorkspaceHooks) HasValues() bool {
+	if w == nil || w.Values == nil {
+		return false
+	}
+
+	if len(w.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetAvatar returns the Avatar field.
+func (w *WorkspaceLinks) GetAvatar() *Link {
+	if w == nil {
+		return nil
+	}
+	return w.Avatar
+}
+
+// GetHooks returns the Hooks field.
+func (w *WorkspaceLinks) GetHooks() *Link {
+	if w == nil {
+		return nil
+	}
+	return w.Hooks
+}
+
+// GetHTML returns the HTML field.
+func (w *WorkspaceLinks) GetHTML() *Link {
+	if w == nil {
+		return nil
+	}
+	return w.HTML
+}
+
+// GetMembers returns the Members field.
+func (w *WorkspaceLinks) GetMembers() *Link {
+	if w == nil {
+		return nil
+	}
+	return w.Members
+}
+
+// GetOwners returns the Owners field.
+func (w *WorkspaceLinks) GetOwners() *Link {
+	if w == nil {
+		return nil
+	}
+	return w.Owners
+}
+
+// GetProjects returns the Projects field.
+func (w *WorkspaceLinks) GetProjects() *Link {
+	if w == nil {
+		return nil
+	}
+	return w.Projects
+}
+
+// GetRepositories returns the Repositories field.
+func (w *WorkspaceLinks) GetRepositories() *Link {
+	if w == nil {
+		return nil
+	}
+	return w.Repositories
+}
+
+// GetSelf returns the Self field.
+func (w *WorkspaceLinks) GetSelf() *Link {
+	if w == nil {
+		return nil
+	}
+	return w.Self
+}
+
+// GetSnippets returns the Snippets field.
+func (w *WorkspaceLinks) GetSnippets() *Link {
+	if w == nil {
+		return nil
+	}
+	return w.Snippets
+}
+
+// GetAddedOn returns the AddedOn field if it's non-nil, zero value otherwise.
+func (w *WorkspaceMembership) GetAddedOn() time.Time {
+	if w == nil || w.AddedOn == nil {
+		return time.Time{}
+	}
+	return *w.AddedOn
+}
+
+// GetLastAccessed returns the LastAccessed field if it's non-nil, zero value otherwise.
+func (w *WorkspaceMembership) GetLastAccessed() time.Time {
+	if w == nil || w.LastAccessed == nil {
+		return time.Time{}
+	}
+	return *w.LastAccessed
+}
+
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (w *WorkspaceMembership) GetPermission() string {
+	if w == nil || w.Permission == nil {
+		return ""
+	}
+	return *w.Permission
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (w *WorkspaceMembership) GetType() string {
+	if w == nil || w.Type == nil {
+		return ""
+	}
+	return *w.Type
+}
+
+// GetUser returns the User field.
+func (w *WorkspaceMembership) GetUser() *User {
+	if w == nil {
+		return nil
+	}
+	return w.User
+}
+
+// GetWorkspace returns the Workspace field.
+func (w *WorkspaceMembership) GetWorkspace() *Workspace {
+	if w == nil {
+		return nil
+	}
+	return w.Workspace
+}
+
+// HasValues checks if WorkspaceMemberships has any Values.
+func (w *WorkspaceMemberships) HasValues() bool {
+	if w == nil || w.Values == nil {
+		return false
+	}
+
+	if len(w.Values) == 0 {
+		return false
+	}
+	return true
+}
+
+// HasValues checks if Workspaces has any Values.
+func (w *Workspaces) HasValues() bool {
+	if w == nil || w.Values == nil {
+		return false
+	}
+
+	if len(w.Values) == 0 {
+		return false
+	}
+	return true
+}
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -11,6 +12,26 @@ import (
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/branch-restrictions
 type BranchRestrictionsService service
 
+const (
+	// BranchRestrictionRequireApprovals requires Value approvals before merging.
+	BranchRestrictionRequireApprovals = "require_approvals_to_merge"
+
+	// BranchRestrictionRequireDefaultReviewerApprovals requires Value approvals from default reviewers before merging.
+	BranchRestrictionRequireDefaultReviewerApprovals = "require_default_reviewer_approvals_to_merge"
+
+	// BranchRestrictionRequirePassingBuilds requires Value successful builds, and none failed or in progress, before merging.
+	BranchRestrictionRequirePassingBuilds = "require_passing_builds_to_merge"
+
+	// BranchRestrictionRequireTasksCompleted requires all pull request tasks to be resolved before merging.
+	BranchRestrictionRequireTasksCompleted = "require_tasks_to_be_completed"
+
+	// BranchRestrictionRequireNoChangesRequested requires that no reviewer requests changes before merging.
+	BranchRestrictionRequireNoChangesRequested = "require_no_changes_requested"
+
+	// BranchRestrictionRestrictMerges restricts merging to the listed users and groups.
+	BranchRestrictionRestrictMerges = "restrict_merges"
+)
+
 // BranchRestrictions represent a collection of branch restrictions.
 type BranchRestrictions struct {
 	PaginationInfo
@@ -26,6 +47,7 @@ type BranchRestriction struct {
 	Pattern         *string  `json:"pattern,omitempty"`
 	Value           *int64   `json:"value,omitempty"`
 	BranchMatchKind *string  `json:"branch_match_kind,omitempty"`
+	BranchType      *string  `json:"branch_type,omitempty"`
 	Type            *string  `json:"type,omitempty"`
 	Links           *BRLinks `json:"links,omitempty"`
 }
@@ -55,14 +77,14 @@ type BranchRestrictionListOpts struct {
 // List returns a paginated list of all branch restrictions on the repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/branch-restrictions#get
-func (br *BranchRestrictionsService) List(owner, repoSlug string, opts ...interface{}) (*BranchRestrictions, *simpleresty.Response, error) {
+func (br *BranchRestrictionsService) List(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*BranchRestrictions, *simpleresty.Response, error) {
 	result := new(BranchRestrictions)
 	urlStr, urlStrErr := br.client.http.RequestURLWithQueryParams(fmt.Sprintf("/repositories/%s/%s/branch-restrictions", owner, repoSlug), opts...)
 	if urlStrErr != nil {
 		return nil, nil, urlStrErr
 	}
 
-	response, err := br.client.http.Get(urlStr, result, nil)
+	response, err := br.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -70,14 +92,14 @@ func (br *BranchRestrictionsService) List(owner, repoSlug string, opts ...interf
 // Get Returns a specific branch restriction.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/branch-restrictions/%7Bid%7D#get
-func (br *BranchRestrictionsService) Get(owner, repoSlug, id string, opts ...interface{}) (*BranchRestriction, *simpleresty.Response, error) {
+func (br *BranchRestrictionsService) Get(ctx context.Context, owner, repoSlug, id string, opts ...interface{}) (*BranchRestriction, *simpleresty.Response, error) {
 	result := new(BranchRestriction)
 	urlStr, urlStrErr := br.client.http.RequestURLWithQueryParams(fmt.Sprintf("/repositories/%s/%s/branch-restrictions/%s", owner, repoSlug, id), opts...)
 	if urlStrErr != nil {
 		return nil, nil, urlStrErr
 	}
 
-	response, err := br.client.http.Get(urlStr, result, nil)
+	response, err := br.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -85,11 +107,11 @@ func (br *BranchRestrictionsService) Get(owner, repoSlug, id string, opts ...int
 // Update updates an existing branch restriction rule.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/branch-restrictions/%7Bid%7D#put
-func (br *BranchRestrictionsService) Update(owner, repoSlug string, brID int64, bo *BRRequest) (*BranchRestriction, *simpleresty.Response, error) {
+func (br *BranchRestrictionsService) Update(ctx context.Context, owner, repoSlug string, brID int64, bo *BRRequest) (*BranchRestriction, *simpleresty.Response, error) {
 	result := new(BranchRestriction)
 	urlStr := br.client.http.RequestURL("/repositories/%s/%s/branch-restrictions/%v", owner, repoSlug, brID)
 
-	response, err := br.client.http.Put(urlStr, result, bo)
+	response, err := br.client.put(ctx, urlStr, result, bo)
 
 	return result, response, err
 }
@@ -97,11 +119,11 @@ func (br *BranchRestrictionsService) Update(owner, repoSlug string, brID int64,
 // Create creates a new branch restriction rule for a repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/branch-restrictions#post
-func (br *BranchRestrictionsService) Create(owner, repoSlug string, bo *BRRequest) (*BranchRestriction, *simpleresty.Response, error) {
+func (br *BranchRestrictionsService) Create(ctx context.Context, owner, repoSlug string, bo *BRRequest) (*BranchRestriction, *simpleresty.Response, error) {
 	result := new(BranchRestriction)
 	urlStr := br.client.http.RequestURL("/repositories/%s/%s/branch-restrictions", owner, repoSlug)
 
-	response, err := br.client.http.Post(urlStr, result, bo)
+	response, err := br.client.post(ctx, urlStr, result, bo)
 
 	return result, response, err
 }
@@ -109,10 +131,10 @@ func (br *BranchRestrictionsService) Create(owner, repoSlug string, bo *BRReques
 // Delete an existing branch restriction rule.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/branch-restrictions/%7Bid%7D#delete
-func (br *BranchRestrictionsService) Delete(owner, repoSlug string, brID int64) (*simpleresty.Response, error) {
+func (br *BranchRestrictionsService) Delete(ctx context.Context, owner, repoSlug string, brID int64) (*simpleresty.Response, error) {
 	urlStr := br.client.http.RequestURL("/repositories/%s/%s/branch-restrictions/%v", owner, repoSlug, brID)
 
-	response, err := br.client.http.Delete(urlStr, nil, nil)
+	response, err := br.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -60,7 +61,7 @@ type BMBranchUpdateOpts struct {
 // Get returns the branching model as applied to the repository. This view is read-only.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/branching-model
-func (bm *BranchingModelService) Get(owner, repoSlug string, opts ...interface{}) (*BranchingModel, *simpleresty.Response, error) {
+func (bm *BranchingModelService) Get(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*BranchingModel, *simpleresty.Response, error) {
 	result := new(BranchingModel)
 	urlStr, urlStrErr := bm.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/issues/branching-model", owner, repoSlug), opts...)
@@ -68,7 +69,7 @@ func (bm *BranchingModelService) Get(owner, repoSlug string, opts ...interface{}
 		return nil, nil, urlStrErr
 	}
 
-	response, err := bm.client.http.Get(urlStr, result, nil)
+	response, err := bm.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -78,7 +79,7 @@ func (bm *BranchingModelService) Get(owner, repoSlug string, opts ...interface{}
 // A client wishing to see the branching model with its actual current branches should use the 'Get' function above.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/branching-model/settings#get
-func (bm *BranchingModelService) GetRaw(owner, repoSlug string, opts ...interface{}) (*BranchingModel, *simpleresty.Response, error) {
+func (bm *BranchingModelService) GetRaw(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*BranchingModel, *simpleresty.Response, error) {
 	result := new(BranchingModel)
 	urlStr, urlStrErr := bm.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/issues/branching-model/settings", owner, repoSlug), opts...)
@@ -86,7 +87,7 @@ func (bm *BranchingModelService) GetRaw(owner, repoSlug string, opts ...interfac
 		return nil, nil, urlStrErr
 	}
 
-	response, err := bm.client.http.Get(urlStr, result, nil)
+	response, err := bm.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -94,11 +95,11 @@ func (bm *BranchingModelService) GetRaw(owner, repoSlug string, opts ...interfac
 // Update update the branching model configuration for a repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/branching-model/settings#put
-func (bm *BranchingModelService) Update(owner, repoSlug string, bo *BMRequest) (*BranchingModel, *simpleresty.Response, error) {
+func (bm *BranchingModelService) Update(ctx context.Context, owner, repoSlug string, bo *BMRequest) (*BranchingModel, *simpleresty.Response, error) {
 	result := new(BranchingModel)
 	urlStr := bm.client.http.RequestURL("/repositories/%s/%s/issues/branching-model/settings", owner, repoSlug)
 
-	response, err := bm.client.http.Put(urlStr, result, bo)
+	response, err := bm.client.put(ctx, urlStr, result, bo)
 
 	return result, response, err
 }
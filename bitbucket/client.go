@@ -1,9 +1,13 @@
 package bitbucket
 
 import (
-	"encoding/base64"
+	"context"
 	"github.com/davidji99/simpleresty"
+	"github.com/go-resty/resty/v2"
 	"golang.org/x/oauth2"
+	"io"
+	"net/http"
+	"sync"
 	"time"
 )
 
@@ -34,14 +38,24 @@ type Client struct {
 	// Reuse a single struct instead of allocating one for each service on the heap.
 	common service
 
-	// basicAuth represents the base64 encoded string for authentication
-	basicAuth *string
+	// authenticator signs every request.
+	authenticator Authenticator
 
-	// bearerToken
-	bearerToken *string
+	// tokenNotify is called with every new token obtained by an OAuth authenticator.
+	tokenNotify func(*oauth2.Token) error
 
-	// oauthGrant
-	oauthGrant *oauth2.Token
+	// transport replaces the transport of the HTTP client when set.
+	transport http.RoundTripper
+
+	// mergePollInterval is the interval at which MergePR polls merge tasks.
+	mergePollInterval time.Duration
+
+	// retryPolicy decides whether failed requests are retried. Requests are not retried when nil.
+	retryPolicy *RetryPolicy
+
+	// rateLimit holds the rate limit state from the most recent response.
+	rateLimit   RateLimit
+	rateLimitMu sync.Mutex
 
 	// Services used for talking to different parts of the Bitbucket API.
 	BranchRestrictions *BranchRestrictionsService
@@ -49,6 +63,7 @@ type Client struct {
 	Commits            *CommitsService
 	Components         *ComponentsService
 	DefaultReviewers   *DefaultReviewersService
+	Deployments        *DeploymentsService
 	DeployKeys         *DeployKeysService
 	Diff               *DiffService
 	Downloads          *DownloadsService
@@ -58,6 +73,8 @@ type Client struct {
 	Issues             *IssuesService
 	Milestones         *MilestonesService
 	Patch              *PatchService
+	Pipelines          *PipelinesService
+	PipelinesConfig    *PipelinesConfigService
 	PullRequests       *PullRequestsService
 	Refs               *RefsService
 	Repositories       *RepositoriesService
@@ -67,6 +84,7 @@ type Client struct {
 	Users              *UsersService
 	Versions           *VersionsService
 	Watchers           *WatchersService
+	Workspaces         *WorkspacesService
 
 	Pagelen uint64
 }
@@ -76,13 +94,35 @@ type service struct {
 }
 
 // New creates a new client using username and an app password, essentially basic authentication.
+//
+// Authentication options such as OAuthBearerToken or OAuthClientCredentials take precedence over username
+// and appPassword, which may then be left empty. If both are empty and no such option is given,
+// the client is anonymous.
 func New(username, appPassword string, opts ...Option) (*Client, error) {
+	var auth Authenticator = Anonymous{}
+	if username != "" || appPassword != "" {
+		auth = &AppPassword{Username: username, Password: appPassword}
+	}
+
+	return newClient(auth, false, opts...)
+}
+
+// NewWithAuth creates a new client that signs every request with auth, such as an AccessToken
+// or OAuthAuthenticator. auth takes precedence over any authentication option.
+func NewWithAuth(auth Authenticator, opts ...Option) (*Client, error) {
+	if auth == nil {
+		auth = Anonymous{}
+	}
+
+	return newClient(auth, true, opts...)
+}
+
+// newClient creates a client authenticated by auth, unless an option sets an authenticator and override is false.
+func newClient(auth Authenticator, override bool, opts ...Option) (*Client, error) {
 	c := &Client{
-		http:        simpleresty.New(),
-		baseURL:     DefaultAPIBaseURL,
-		userAgent:   DefaultUserAgent,
-		basicAuth:   nil,
-		bearerToken: nil,
+		http:      simpleresty.New(),
+		baseURL:   DefaultAPIBaseURL,
+		userAgent: DefaultUserAgent,
 	}
 
 	// Define any user custom Client settings
@@ -90,9 +130,13 @@ func New(username, appPassword string, opts ...Option) (*Client, error) {
 		return nil, optErr
 	}
 
-	// Generate base64 string using username & appPassword
-	a := base64.StdEncoding.EncodeToString([]byte(username + ":" + appPassword))
-	c.basicAuth = &a
+	if override || c.authenticator == nil {
+		c.authenticator = auth
+	}
+
+	if oauth, ok := c.authenticator.(*OAuthAuthenticator); ok && c.tokenNotify != nil {
+		c.authenticator = &OAuthAuthenticator{Source: &notifyTokenSource{src: oauth.Source, notify: c.tokenNotify}}
+	}
 
 	// Setup the client with default settings
 	c.setupClient()
@@ -118,26 +162,48 @@ func (c *Client) setupClient() {
 	// Set Auth headers
 	c.addAuthHeaders()
 
+	// Set a custom transport
+	if c.transport != nil {
+		c.http.SetTransport(c.transport)
+	}
+
 	// Set additional headers
 	if c.customHTTPHeaders != nil {
 		c.http.SetHeaders(c.customHTTPHeaders)
 	}
 }
 
+// addAuthHeaders signs every request, including retries, with the client's authenticator.
+//
+// The credentials are then handed to a transport that must not persist them. This happens on every request,
+// as an OAuth token may have been refreshed since the previous one.
 func (c *Client) addAuthHeaders() {
-	if c.bearerToken != nil {
-		c.http.SetHeader("Authorization", "Bearer "+*c.bearerToken)
-	}
+	c.http.SetPreRequestHook(func(_ *resty.Client, req *http.Request) error {
+		if err := c.authenticator.Authenticate(req); err != nil {
+			return err
+		}
 
-	if c.basicAuth != nil {
-		c.http.SetHeader("Authorization", "Basic "+*c.basicAuth)
-		return
-	}
+		if r, ok := c.transport.(redactor); ok {
+			r.Redact(c.secrets()...)
+		}
+
+		return nil
+	})
+}
+
+// redactor is implemented by transports, such as a cassette.Recorder, that persist requests
+// and need to know which values to redact.
+type redactor interface {
+	Redact(secrets ...string)
+}
 
-	if c.oauthGrant.Valid() {
-		c.oauthGrant.SetAuthHeader(c.http.R().RawRequest)
+// secrets returns the credentials of the client's authenticator used by the latest request.
+func (c *Client) secrets() []string {
+	if a, ok := c.authenticator.(interface{ secrets() []string }); ok {
+		return a.secrets()
 	}
-	return
+
+	return nil
 }
 
 // injectClient adds all resource services to the client.
@@ -149,6 +215,7 @@ func (c *Client) injectServices() *Client {
 	c.Commits = (*CommitsService)(&c.common)
 	c.Components = (*ComponentsService)(&c.common)
 	c.DefaultReviewers = (*DefaultReviewersService)(&c.common)
+	c.Deployments = (*DeploymentsService)(&c.common)
 	c.DeployKeys = (*DeployKeysService)(&c.common)
 	c.Diff = (*DiffService)(&c.common)
 	c.Downloads = (*DownloadsService)(&c.common)
@@ -158,6 +225,8 @@ func (c *Client) injectServices() *Client {
 	c.Issues = (*IssuesService)(&c.common)
 	c.Milestones = (*MilestonesService)(&c.common)
 	c.Patch = (*PatchService)(&c.common)
+	c.Pipelines = (*PipelinesService)(&c.common)
+	c.PipelinesConfig = (*PipelinesConfigService)(&c.common)
 	c.PullRequests = (*PullRequestsService)(&c.common)
 	c.Refs = (*RefsService)(&c.common)
 	c.Repositories = (*RepositoriesService)(&c.common)
@@ -167,10 +236,91 @@ func (c *Client) injectServices() *Client {
 	c.Users = (*UsersService)(&c.common)
 	c.Versions = (*VersionsService)(&c.common)
 	c.Watchers = (*WatchersService)(&c.common)
+	c.Workspaces = (*WorkspacesService)(&c.common)
 
 	return c
 }
 
+// newRequest returns a new request bound to ctx.
+//
+// The request is built through ConstructRequest so that any proxy settings on the HTTP client are applied.
+func (c *Client) newRequest(ctx context.Context) *resty.Request {
+	return c.http.ConstructRequest(nil, nil).SetContext(ctx)
+}
+
+// dispatch sends a request created by newRequest and checks its response.
+//
+// A body set from an io.Reader is read into memory first, as it would otherwise be empty when the request is retried.
+func (c *Client) dispatch(req *resty.Request) (*simpleresty.Response, error) {
+	if r, ok := req.Body.(io.Reader); ok {
+		body, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		req.SetBody(body)
+	}
+
+	return c.execute(req.Context(), req.Method, req.Send)
+}
+
+// do executes a HTTP request bound to ctx. Cancelling ctx aborts the request.
+func (c *Client) do(ctx context.Context, method, urlStr string, r, body interface{}) (*simpleresty.Response, error) {
+	return c.execute(ctx, method, func() (*resty.Response, error) {
+		return c.http.ConstructRequest(r, body).SetContext(ctx).Execute(method, urlStr)
+	})
+}
+
+// execute runs send, retrying it according to the client's retry policy, and checks the final response.
+func (c *Client) execute(ctx context.Context, method string, send func() (*resty.Response, error)) (*simpleresty.Response, error) {
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		resp, err := send()
+		if resp != nil && resp.RawResponse != nil {
+			c.updateRateLimit(resp.Header())
+		}
+
+		wait, retry := c.retryPolicy.shouldRetry(ctx, method, resp, err, attempt, time.Since(start))
+		if retry {
+			if sleepErr := sleep(ctx, wait); sleepErr != nil {
+				return nil, sleepErr
+			}
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		return checkResponse(resp)
+	}
+}
+
+// get executes a HTTP GET request.
+func (c *Client) get(ctx context.Context, urlStr string, r, body interface{}) (*simpleresty.Response, error) {
+	return c.do(ctx, simpleresty.GetMethod, urlStr, r, body)
+}
+
+// post executes a HTTP POST request.
+func (c *Client) post(ctx context.Context, urlStr string, r, body interface{}) (*simpleresty.Response, error) {
+	return c.do(ctx, simpleresty.PostMethod, urlStr, r, body)
+}
+
+// put executes a HTTP PUT request.
+func (c *Client) put(ctx context.Context, urlStr string, r, body interface{}) (*simpleresty.Response, error) {
+	return c.do(ctx, simpleresty.PutMethod, urlStr, r, body)
+}
+
+// patch executes a HTTP PATCH request.
+func (c *Client) patch(ctx context.Context, urlStr string, r, body interface{}) (*simpleresty.Response, error) {
+	return c.do(ctx, simpleresty.PatchMethod, urlStr, r, body)
+}
+
+// delete executes a HTTP DELETE request.
+func (c *Client) delete(ctx context.Context, urlStr string, r, body interface{}) (*simpleresty.Response, error) {
+	return c.do(ctx, simpleresty.DeleteMethod, urlStr, r, body)
+}
+
 // parseOptions parses the supplied options functions and returns a configured *Client instance.
 func (c *Client) parseOptions(opts ...Option) error {
 	// Range over each options function and apply it to our API type to
@@ -7,6 +7,8 @@ import (
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/bitbucket"
 	"golang.org/x/oauth2/clientcredentials"
+	"net/http"
+	"time"
 )
 
 // Option is a functional option for configuring the API client.
@@ -19,6 +21,18 @@ func HTTP(http *simpleresty.Client) Option {
 	}
 }
 
+// Transport sets the transport used to send requests, such as a cassette.Recorder
+// to record and replay interactions with the API.
+//
+// If the transport has a Redact(secrets ...string) method, it is called with the client's credentials
+// before every request, so that refreshed OAuth tokens are redacted too.
+func Transport(rt http.RoundTripper) Option {
+	return func(c *Client) error {
+		c.transport = rt
+		return nil
+	}
+}
+
 // UserAgent allows overriding of the default User Agent.
 func UserAgent(userAgent string) Option {
 	return func(c *Client) error {
@@ -48,31 +62,61 @@ func CustomHTTPHeaders(headers map[string]string) Option {
 	}
 }
 
+// Retry enables retrying of failed requests using the given policy. Zero fields of the policy use their defaults.
+func Retry(policy RetryPolicy) Option {
+	return func(c *Client) error {
+		if policy.MaxAttempts <= 0 {
+			policy.MaxAttempts = DefaultRetryMaxAttempts
+		}
+		if policy.InitialInterval <= 0 {
+			policy.InitialInterval = DefaultRetryInitialInterval
+		}
+		if policy.MaxInterval <= 0 {
+			policy.MaxInterval = DefaultRetryMaxInterval
+		}
+
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// MergePollInterval sets the interval at which PullRequestsService.MergePR polls the status of a merge
+// that Bitbucket runs in the background. It defaults to DefaultMergePollInterval.
+func MergePollInterval(d time.Duration) Option {
+	return func(c *Client) error {
+		c.mergePollInterval = d
+		return nil
+	}
+}
+
 // OAuthClientCredentials uses the Client Credentials Grant oauth2 flow to authenticate to Bitbucket.
-func OAuthClientCredentials(clientID, clientSecret string) Option {
+// A new token is requested whenever the current one expires.
+//
+// ctx is used for the token requests, so it must not be cancelled while the client is in use.
+func OAuthClientCredentials(ctx context.Context, clientID, clientSecret string) Option {
 	return func(c *Client) error {
-		ctx := context.Background()
 		conf := &clientcredentials.Config{
 			ClientID:     clientID,
 			ClientSecret: clientSecret,
 			TokenURL:     bitbucket.Endpoint.TokenURL,
 		}
 
-		token, err := conf.Token(ctx)
-		if err != nil {
+		ts := conf.TokenSource(ctx)
+		if _, err := ts.Token(); err != nil {
 			return err
 		}
 
-		c.oauthGrant = token
+		c.authenticator = &OAuthAuthenticator{Source: ts}
 
 		return nil
 	}
 }
 
-// OAuth with oauth.
-func OAuth(clientID, clientSecret string) Option {
+// OAuth with oauth. The token is refreshed whenever it expires.
+//
+// ctx is used for the code exchange and token refreshes, so it must not be cancelled while the client is in use.
+func OAuth(ctx context.Context, clientID, clientSecret string) Option {
 	return func(c *Client) error {
-		ctx := context.Background()
 		conf := &oauth2.Config{
 			ClientID:     clientID,
 			ClientSecret: clientSecret,
@@ -86,8 +130,8 @@ func OAuth(clientID, clientSecret string) Option {
 
 		// Use the authorization code that is pushed to the redirect
 		// URL. Exchange will do the handshake to retrieve the
-		// initial access token. The HTTP Client returned by
-		// conf.Client will refresh the token as necessary.
+		// initial access token. The token source returned by
+		// conf.TokenSource will refresh the token as necessary.
 		var code string
 		fmt.Printf("Enter the code in the return URL: ")
 		if _, err := fmt.Scan(&code); err != nil {
@@ -99,16 +143,17 @@ func OAuth(clientID, clientSecret string) Option {
 			return err
 		}
 
-		c.oauthGrant = token
+		c.authenticator = &OAuthAuthenticator{Source: conf.TokenSource(ctx, token)}
 
 		return nil
 	}
 }
 
-// OAuthWithCode does the OAuth handshake with a given code.
-func OAuthWithCode(clientID, clientSecret, code string) Option {
+// OAuthWithCode does the OAuth handshake with a given code. The token is refreshed whenever it expires.
+//
+// ctx is used for the code exchange and token refreshes, so it must not be cancelled while the client is in use.
+func OAuthWithCode(ctx context.Context, clientID, clientSecret, code string) Option {
 	return func(c *Client) error {
-		ctx := context.Background()
 		conf := &oauth2.Config{
 			ClientID:     clientID,
 			ClientSecret: clientSecret,
@@ -119,24 +164,63 @@ func OAuthWithCode(clientID, clientSecret, code string) Option {
 		if err != nil {
 			return err
 		}
-		c.oauthGrant = token
+
+		c.authenticator = &OAuthAuthenticator{Source: conf.TokenSource(ctx, token)}
 
 		return nil
 	}
 }
 
-// OAuthToken sets the oauth grant.
+// OAuthToken sets the oauth grant. The token is never refreshed, use OAuthRefreshableToken
+// to refresh a previously saved token.
 func OAuthToken(ot oauth2.Token) Option {
 	return func(c *Client) error {
-		c.oauthGrant = &ot
+		c.authenticator = &OAuthAuthenticator{Source: oauth2.StaticTokenSource(&ot)}
+		return nil
+	}
+}
+
+// OAuthRefreshableToken sets a previously obtained oauth grant, such as one saved by OAuthTokenNotify,
+// and refreshes it with its refresh token whenever it expires.
+//
+// ctx is used for the token refreshes, so it must not be cancelled while the client is in use.
+func OAuthRefreshableToken(ctx context.Context, clientID, clientSecret string, ot oauth2.Token) Option {
+	return func(c *Client) error {
+		conf := &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Endpoint:     bitbucket.Endpoint,
+		}
+
+		c.authenticator = &OAuthAuthenticator{Source: conf.TokenSource(ctx, &ot)}
+
+		return nil
+	}
+}
+
+// OAuthTokenSource authenticates each request with a token from ts. ts is responsible for refreshing
+// the token and is called before every request, so it should cache the token, e.g. with oauth2.ReuseTokenSource.
+func OAuthTokenSource(ts oauth2.TokenSource) Option {
+	return func(c *Client) error {
+		c.authenticator = &OAuthAuthenticator{Source: ts}
+		return nil
+	}
+}
+
+// OAuthTokenNotify calls fn with every new token the client obtains, including refreshed ones,
+// so they can be persisted. A request fails if fn returns an error.
+func OAuthTokenNotify(fn func(*oauth2.Token) error) Option {
+	return func(c *Client) error {
+		c.tokenNotify = fn
 		return nil
 	}
 }
 
-// OAuthBearerToken sets the oauth grant.
+// OAuthBearerToken authenticates with a bearer token, such as an OAuth access token
+// or a repository, project or workspace access token.
 func OAuthBearerToken(t string) Option {
 	return func(c *Client) error {
-		c.bearerToken = &t
+		c.authenticator = &AccessToken{Token: t}
 		return nil
 	}
 }
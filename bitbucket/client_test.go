@@ -0,0 +1,126 @@
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+func TestClient_ContextCancel(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, _, getErr := client.Repositories.Get(ctx, "owner", "repo")
+	assert.True(t, errors.Is(getErr, context.DeadlineExceeded))
+}
+
+type countingTokenSource struct {
+	calls int
+}
+
+func (s *countingTokenSource) Token() (*oauth2.Token, error) {
+	s.calls++
+	return &oauth2.Token{AccessToken: fmt.Sprintf("token-%d", s.calls), TokenType: "Bearer"}, nil
+}
+
+func TestClient_OAuthTokenSource(t *testing.T) {
+	var auth []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"slug":"repo"}`))
+	}))
+	defer server.Close()
+
+	var saved []string
+	client, err := New("user", "password", BaseURL(server.URL),
+		OAuthTokenSource(&countingTokenSource{}),
+		OAuthTokenNotify(func(token *oauth2.Token) error {
+			saved = append(saved, token.AccessToken)
+			return nil
+		}))
+	assert.Nil(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, _, getErr := client.Repositories.Get(context.Background(), "owner", "repo")
+		assert.Nil(t, getErr)
+	}
+
+	assert.Equal(t, []string{"Bearer token-1", "Bearer token-2"}, auth)
+	assert.Equal(t, []string{"token-1", "token-2"}, saved)
+}
+
+func TestClient_OAuthTokenNotifyError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent")
+	}))
+	defer server.Close()
+
+	notifyErr := errors.New("disk full")
+	client, err := New("user", "password", BaseURL(server.URL),
+		OAuthToken(oauth2.Token{AccessToken: "token"}),
+		OAuthTokenNotify(func(token *oauth2.Token) error {
+			return notifyErr
+		}))
+	assert.Nil(t, err)
+
+	_, _, getErr := client.Repositories.Get(context.Background(), "owner", "repo")
+	assert.True(t, errors.Is(getErr, notifyErr))
+}
+
+// redactingTransport records the secrets registered through its Redact method.
+type redactingTransport struct {
+	secrets []string
+}
+
+func (t *redactingTransport) Redact(secrets ...string) {
+	t.secrets = append(t.secrets, secrets...)
+}
+
+func (t *redactingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClient_TransportRedactsRefreshedTokens(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"slug":"repo"}`))
+	}))
+	defer server.Close()
+
+	transport := &redactingTransport{}
+	source := &countingTokenSource{}
+	client, err := New("user", "password", BaseURL(server.URL), Transport(transport), OAuthTokenSource(source))
+	assert.Nil(t, err)
+	assert.Empty(t, transport.secrets)
+
+	for i := 0; i < 2; i++ {
+		_, _, getErr := client.Repositories.Get(context.Background(), "owner", "repo")
+		assert.Nil(t, getErr)
+	}
+
+	// Each token is registered once it is used, without asking the source for another one.
+	assert.Equal(t, 2, source.calls)
+	assert.Contains(t, transport.secrets, "token-1")
+	assert.Contains(t, transport.secrets, "token-2")
+}
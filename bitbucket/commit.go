@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"time"
@@ -38,6 +39,7 @@ type Participant struct {
 	ParticipatedOn *time.Time `json:"participated_on,omitempty"`
 	Type           *string    `json:"type,omitempty"`
 	Approved       *bool      `json:"approved,omitempty"`
+	State          *string    `json:"state,omitempty"`
 	User           *User      `json:"user,omitempty"`
 }
 
@@ -54,7 +56,7 @@ type CommitLinks struct {
 // Get return the specified commit.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/commit/%7Bnode%7D#get
-func (c *CommitService) Get(owner, repoSlug, sha string, opts ...interface{}) (*Commit, *simpleresty.Response, error) {
+func (c *CommitService) Get(ctx context.Context, owner, repoSlug, sha string, opts ...interface{}) (*Commit, *simpleresty.Response, error) {
 	results := new(Commit)
 	urlStr, urlStrErr := c.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/commit/%s", owner, repoSlug, sha), opts...)
@@ -62,7 +64,7 @@ func (c *CommitService) Get(owner, repoSlug, sha string, opts ...interface{}) (*
 		return nil, nil, urlStrErr
 	}
 
-	response, err := c.client.http.Get(urlStr, results, nil)
+	response, err := c.client.get(ctx, urlStr, results, nil)
 
 	return results, response, err
 }
@@ -1,6 +1,9 @@
 package bitbucket
 
-import "github.com/davidji99/simpleresty"
+import (
+	"context"
+	"github.com/davidji99/simpleresty"
+)
 
 // Approve approves the specified commit as the authenticated user.
 //
@@ -8,10 +11,10 @@ import "github.com/davidji99/simpleresty"
 // In contrast, just the fact that a repository is publicly accessible to users does not give them the ability to approve commits.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/commit/%7Bnode%7D/approve#post
-func (c *CommitService) Approve(owner, repoSlug, sha string) (*Participant, *simpleresty.Response, error) {
+func (c *CommitService) Approve(ctx context.Context, owner, repoSlug, sha string) (*Participant, *simpleresty.Response, error) {
 	results := new(Participant)
 	urlStr := c.client.http.RequestURL("/repositories/%s/%s/commit/%s/approve", owner, repoSlug, sha)
-	response, err := c.client.http.Post(urlStr, results, nil)
+	response, err := c.client.post(ctx, urlStr, results, nil)
 
 	return results, response, err
 }
@@ -22,9 +25,9 @@ func (c *CommitService) Approve(owner, repoSlug, sha string) (*Participant, *sim
 // In contrast, just the fact that a repository is publicly accessible to users does not give them the ability to approve commits.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/commit/%7Bnode%7D/approve#delete
-func (c *CommitService) UnApprove(owner, repoSlug, sha string) (*simpleresty.Response, error) {
+func (c *CommitService) UnApprove(ctx context.Context, owner, repoSlug, sha string) (*simpleresty.Response, error) {
 	urlStr := c.client.http.RequestURL("/repositories/%s/%s/commit/%s/approve", owner, repoSlug, sha)
-	response, err := c.client.http.Delete(urlStr, nil, nil)
+	response, err := c.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"time"
@@ -45,7 +46,7 @@ type CommitCommentRequest struct {
 // The default sorting is oldest to newest and can be overridden with the sort query parameter.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/commit/%7Bnode%7D/comments#get
-func (c *CommitService) ListComments(owner, repoSlug, sha string, opts ...interface{}) (*CommitComments, *simpleresty.Response, error) {
+func (c *CommitService) ListComments(ctx context.Context, owner, repoSlug, sha string, opts ...interface{}) (*CommitComments, *simpleresty.Response, error) {
 	results := new(CommitComments)
 	urlStr, urlStrErr := c.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/commit/%s/comments", owner, repoSlug, sha), opts...)
@@ -53,7 +54,7 @@ func (c *CommitService) ListComments(owner, repoSlug, sha string, opts ...interf
 		return nil, nil, urlStrErr
 	}
 
-	response, err := c.client.http.Get(urlStr, results, nil)
+	response, err := c.client.get(ctx, urlStr, results, nil)
 
 	return results, response, err
 }
@@ -61,10 +62,10 @@ func (c *CommitService) ListComments(owner, repoSlug, sha string, opts ...interf
 // CreateComment creates new comment on the specified commit.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/commit/%7Bnode%7D/comments#post
-func (c *CommitService) CreateComment(owner, repoSlug, sha string, co *CommitCommentRequest) (*CommitComment, *simpleresty.Response, error) {
+func (c *CommitService) CreateComment(ctx context.Context, owner, repoSlug, sha string, co *CommitCommentRequest) (*CommitComment, *simpleresty.Response, error) {
 	results := new(CommitComment)
 	urlStr := c.client.http.RequestURL("/repositories/%s/%s/commit/%s/comments", owner, repoSlug, sha)
-	response, err := c.client.http.Post(urlStr, results, co)
+	response, err := c.client.post(ctx, urlStr, results, co)
 
 	return results, response, err
 }
@@ -72,7 +73,7 @@ func (c *CommitService) CreateComment(owner, repoSlug, sha string, co *CommitCom
 // GetComment returns the specified commit comment.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/commit/%7Bnode%7D/comments/%7Bcomment_id%7D#get
-func (c *CommitService) GetComment(owner, repoSlug, sha string, cID int64, opts ...interface{}) (*CommitComment, *simpleresty.Response, error) {
+func (c *CommitService) GetComment(ctx context.Context, owner, repoSlug, sha string, cID int64, opts ...interface{}) (*CommitComment, *simpleresty.Response, error) {
 	results := new(CommitComment)
 	urlStr, urlStrErr := c.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/commit/%s/comments/%v", owner, repoSlug, sha, cID), opts...)
@@ -80,7 +81,7 @@ func (c *CommitService) GetComment(owner, repoSlug, sha string, cID int64, opts
 		return nil, nil, urlStrErr
 	}
 
-	response, err := c.client.http.Get(urlStr, results, nil)
+	response, err := c.client.get(ctx, urlStr, results, nil)
 
 	return results, response, err
 }
@@ -0,0 +1,308 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"time"
+)
+
+const (
+	// ReportTypeSecurity is the report type of security scans.
+	ReportTypeSecurity = "SECURITY"
+
+	// ReportTypeCoverage is the report type of code coverage.
+	ReportTypeCoverage = "COVERAGE"
+
+	// ReportTypeTest is the report type of test runs.
+	ReportTypeTest = "TEST"
+
+	// ReportTypeBug is the report type of bug finders such as static analysis.
+	ReportTypeBug = "BUG"
+)
+
+const (
+	// ReportResultPassed marks a report or annotation as passed.
+	ReportResultPassed = "PASSED"
+
+	// ReportResultFailed marks a report or annotation as failed.
+	ReportResultFailed = "FAILED"
+
+	// ReportResultPending marks a report whose analysis is still running.
+	ReportResultPending = "PENDING"
+
+	// ReportResultSkipped marks an annotation as skipped.
+	ReportResultSkipped = "SKIPPED"
+
+	// ReportResultIgnored marks an annotation as ignored.
+	ReportResultIgnored = "IGNORED"
+)
+
+const (
+	// ReportDataTypeBoolean holds a bool value.
+	ReportDataTypeBoolean = "BOOLEAN"
+
+	// ReportDataTypeDate holds a date as milliseconds since the Unix epoch.
+	ReportDataTypeDate = "DATE"
+
+	// ReportDataTypeDuration holds a duration in milliseconds.
+	ReportDataTypeDuration = "DURATION"
+
+	// ReportDataTypeLink holds a *ReportDataLink value.
+	ReportDataTypeLink = "LINK"
+
+	// ReportDataTypeNumber holds a numeric value.
+	ReportDataTypeNumber = "NUMBER"
+
+	// ReportDataTypePercentage holds a number between 0 and 100.
+	ReportDataTypePercentage = "PERCENTAGE"
+
+	// ReportDataTypeText holds a string value.
+	ReportDataTypeText = "TEXT"
+)
+
+const (
+	// AnnotationTypeVulnerability marks an annotation as a security vulnerability.
+	AnnotationTypeVulnerability = "VULNERABILITY"
+
+	// AnnotationTypeCodeSmell marks an annotation as a code smell.
+	AnnotationTypeCodeSmell = "CODE_SMELL"
+
+	// AnnotationTypeBug marks an annotation as a bug.
+	AnnotationTypeBug = "BUG"
+)
+
+const (
+	// AnnotationSeverityCritical is the highest annotation severity.
+	AnnotationSeverityCritical = "CRITICAL"
+
+	// AnnotationSeverityHigh is a high annotation severity.
+	AnnotationSeverityHigh = "HIGH"
+
+	// AnnotationSeverityMedium is a medium annotation severity.
+	AnnotationSeverityMedium = "MEDIUM"
+
+	// AnnotationSeverityLow is the lowest annotation severity.
+	AnnotationSeverityLow = "LOW"
+)
+
+// Reports represents a collection of Code Insights reports.
+type Reports struct {
+	PaginationInfo
+
+	Values []*Report `json:"values,omitempty"`
+}
+
+// Report represents a Code Insights report attached to a commit, such as the results of a static analysis run.
+type Report struct {
+	Type              *string       `json:"type,omitempty"`
+	UUID              *string       `json:"uuid,omitempty"`
+	Title             *string       `json:"title,omitempty"`
+	Details           *string       `json:"details,omitempty"`
+	ExternalID        *string       `json:"external_id,omitempty"`
+	Reporter          *string       `json:"reporter,omitempty"`
+	Link              *string       `json:"link,omitempty"`
+	RemoteLinkEnabled *bool         `json:"remote_link_enabled,omitempty"`
+	LogoURL           *string       `json:"logo_url,omitempty"`
+	ReportType        *string       `json:"report_type,omitempty"`
+	Result            *string       `json:"result,omitempty"`
+	Data              []*ReportData `json:"data,omitempty"`
+	CreatedOn         *time.Time    `json:"created_on,omitempty"`
+	UpdatedOn         *time.Time    `json:"updated_on,omitempty"`
+}
+
+// ReportData represents a single typed data field displayed on a report.
+//
+// The Go type of Value depends on Type: bool for ReportDataTypeBoolean, string for ReportDataTypeText,
+// *ReportDataLink for ReportDataTypeLink and a number for the remaining types.
+// Values decoded from a response follow encoding/json, so numbers are float64 and links are map[string]interface{}.
+type ReportData struct {
+	Title *string     `json:"title,omitempty"`
+	Type  *string     `json:"type,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ReportDataLink represents the value of a ReportDataTypeLink data field.
+type ReportDataLink struct {
+	Text *string `json:"text,omitempty"`
+	Href *string `json:"href,omitempty"`
+}
+
+// ReportRequest represents a request to create/update a report.
+type ReportRequest struct {
+	Title             *string       `json:"title,omitempty"`
+	Details           *string       `json:"details,omitempty"`
+	ExternalID        *string       `json:"external_id,omitempty"`
+	Reporter          *string       `json:"reporter,omitempty"`
+	Link              *string       `json:"link,omitempty"`
+	RemoteLinkEnabled *bool         `json:"remote_link_enabled,omitempty"`
+	LogoURL           *string       `json:"logo_url,omitempty"`
+	ReportType        *string       `json:"report_type,omitempty"`
+	Result            *string       `json:"result,omitempty"`
+	Data              []*ReportData `json:"data,omitempty"`
+}
+
+// ReportAnnotations represents a collection of report annotations.
+type ReportAnnotations struct {
+	PaginationInfo
+
+	Values []*ReportAnnotation `json:"values,omitempty"`
+}
+
+// ReportAnnotation represents a single finding of a report, optionally tied to a line of a file.
+type ReportAnnotation struct {
+	Type           *string    `json:"type,omitempty"`
+	UUID           *string    `json:"uuid,omitempty"`
+	ExternalID     *string    `json:"external_id,omitempty"`
+	AnnotationType *string    `json:"annotation_type,omitempty"`
+	Path           *string    `json:"path,omitempty"`
+	Line           *int64     `json:"line,omitempty"`
+	Summary        *string    `json:"summary,omitempty"`
+	Details        *string    `json:"details,omitempty"`
+	Result         *string    `json:"result,omitempty"`
+	Severity       *string    `json:"severity,omitempty"`
+	Link           *string    `json:"link,omitempty"`
+	CreatedOn      *time.Time `json:"created_on,omitempty"`
+	UpdatedOn      *time.Time `json:"updated_on,omitempty"`
+}
+
+// ReportAnnotationRequest represents a request to create/update an annotation.
+//
+// ExternalID is required and identifies the annotation within its report.
+type ReportAnnotationRequest struct {
+	ExternalID     *string `json:"external_id,omitempty"`
+	AnnotationType *string `json:"annotation_type,omitempty"`
+	Path           *string `json:"path,omitempty"`
+	Line           *int64  `json:"line,omitempty"`
+	Summary        *string `json:"summary,omitempty"`
+	Details        *string `json:"details,omitempty"`
+	Result         *string `json:"result,omitempty"`
+	Severity       *string `json:"severity,omitempty"`
+	Link           *string `json:"link,omitempty"`
+}
+
+// ListReports returns the Code Insights reports of a commit.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-reports/#api-repositories-workspace-repo-slug-commit-commit-reports-get
+func (c *CommitService) ListReports(ctx context.Context, owner, repoSlug, sha string, opts ...interface{}) (*Reports, *simpleresty.Response, error) {
+	results := new(Reports)
+	urlStr, urlStrErr := c.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/commit/%s/reports", owner, repoSlug, sha), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := c.client.get(ctx, urlStr, results, nil)
+
+	return results, response, err
+}
+
+// GetReport returns a single report of a commit.
+//
+// Accepts the report's UUID or external id.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-reports/#api-repositories-workspace-repo-slug-commit-commit-reports-reportid-get
+func (c *CommitService) GetReport(ctx context.Context, owner, repoSlug, sha, reportID string, opts ...interface{}) (*Report, *simpleresty.Response, error) {
+	results := new(Report)
+	urlStr, urlStrErr := c.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/commit/%s/reports/%s", owner, repoSlug, sha, reportID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := c.client.get(ctx, urlStr, results, nil)
+
+	return results, response, err
+}
+
+// CreateOrUpdateReport creates a report on a commit, or replaces the report with the same external id.
+//
+// Replacing a report deletes all of its annotations.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-reports/#api-repositories-workspace-repo-slug-commit-commit-reports-reportid-put
+func (c *CommitService) CreateOrUpdateReport(ctx context.Context, owner, repoSlug, sha, reportID string, ro *ReportRequest) (*Report, *simpleresty.Response, error) {
+	results := new(Report)
+	urlStr := c.client.http.RequestURL("/repositories/%s/%s/commit/%s/reports/%s", owner, repoSlug, sha, reportID)
+	response, err := c.client.put(ctx, urlStr, results, ro)
+
+	return results, response, err
+}
+
+// DeleteReport deletes a report, and its annotations, from a commit.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-reports/#api-repositories-workspace-repo-slug-commit-commit-reports-reportid-delete
+func (c *CommitService) DeleteReport(ctx context.Context, owner, repoSlug, sha, reportID string) (*simpleresty.Response, error) {
+	urlStr := c.client.http.RequestURL("/repositories/%s/%s/commit/%s/reports/%s", owner, repoSlug, sha, reportID)
+	response, err := c.client.delete(ctx, urlStr, nil, nil)
+
+	return response, err
+}
+
+// ListAnnotations returns the annotations of a report.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-reports/#api-repositories-workspace-repo-slug-commit-commit-reports-reportid-annotations-get
+func (c *CommitService) ListAnnotations(ctx context.Context, owner, repoSlug, sha, reportID string, opts ...interface{}) (*ReportAnnotations, *simpleresty.Response, error) {
+	results := new(ReportAnnotations)
+	urlStr, urlStrErr := c.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/commit/%s/reports/%s/annotations", owner, repoSlug, sha, reportID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := c.client.get(ctx, urlStr, results, nil)
+
+	return results, response, err
+}
+
+// GetAnnotation returns a single annotation of a report.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-reports/#api-repositories-workspace-repo-slug-commit-commit-reports-reportid-annotations-annotationid-get
+func (c *CommitService) GetAnnotation(ctx context.Context, owner, repoSlug, sha, reportID, annotationID string, opts ...interface{}) (*ReportAnnotation, *simpleresty.Response, error) {
+	results := new(ReportAnnotation)
+	urlStr, urlStrErr := c.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/commit/%s/reports/%s/annotations/%s",
+			owner, repoSlug, sha, reportID, annotationID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := c.client.get(ctx, urlStr, results, nil)
+
+	return results, response, err
+}
+
+// CreateAnnotations adds annotations to a report in bulk.
+//
+// Bitbucket accepts up to 100 annotations per request and 1000 per report.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-reports/#api-repositories-workspace-repo-slug-commit-commit-reports-reportid-annotations-post
+func (c *CommitService) CreateAnnotations(ctx context.Context, owner, repoSlug, sha, reportID string, ao []*ReportAnnotationRequest) ([]*ReportAnnotation, *simpleresty.Response, error) {
+	results := make([]*ReportAnnotation, 0)
+	urlStr := c.client.http.RequestURL("/repositories/%s/%s/commit/%s/reports/%s/annotations", owner, repoSlug, sha, reportID)
+	response, err := c.client.post(ctx, urlStr, &results, ao)
+
+	return results, response, err
+}
+
+// CreateOrUpdateAnnotation creates a single annotation on a report, or replaces the annotation with the same external id.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-reports/#api-repositories-workspace-repo-slug-commit-commit-reports-reportid-annotations-annotationid-put
+func (c *CommitService) CreateOrUpdateAnnotation(ctx context.Context, owner, repoSlug, sha, reportID, annotationID string, ao *ReportAnnotationRequest) (*ReportAnnotation, *simpleresty.Response, error) {
+	results := new(ReportAnnotation)
+	urlStr := c.client.http.RequestURL("/repositories/%s/%s/commit/%s/reports/%s/annotations/%s",
+		owner, repoSlug, sha, reportID, annotationID)
+	response, err := c.client.put(ctx, urlStr, results, ao)
+
+	return results, response, err
+}
+
+// DeleteAnnotation deletes a single annotation from a report.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-reports/#api-repositories-workspace-repo-slug-commit-commit-reports-reportid-annotations-annotationid-delete
+func (c *CommitService) DeleteAnnotation(ctx context.Context, owner, repoSlug, sha, reportID, annotationID string) (*simpleresty.Response, error) {
+	urlStr := c.client.http.RequestURL("/repositories/%s/%s/commit/%s/reports/%s/annotations/%s",
+		owner, repoSlug, sha, reportID, annotationID)
+	response, err := c.client.delete(ctx, urlStr, nil, nil)
+
+	return response, err
+}
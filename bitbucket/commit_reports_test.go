@@ -0,0 +1,45 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommitService_CreateAnnotations(t *testing.T) {
+	var body []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/repositories/owner/repo/commit/abc123/reports/lint/annotations", r.URL.Path)
+		json.NewDecoder(r.Body).Decode(&body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"uuid":"{a-1}","external_id":"a1","severity":"HIGH"},{"uuid":"{a-2}","external_id":"a2"}]`))
+	}))
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	id1, id2, path, line := "a1", "a2", "main.go", int64(12)
+	annotationType, severity := AnnotationTypeCodeSmell, AnnotationSeverityHigh
+
+	annotations, _, createErr := client.Commit.CreateAnnotations(context.Background(), "owner", "repo", "abc123", "lint",
+		[]*ReportAnnotationRequest{
+			{ExternalID: &id1, AnnotationType: &annotationType, Severity: &severity, Path: &path, Line: &line},
+			{ExternalID: &id2},
+		})
+	assert.Nil(t, createErr)
+	assert.Len(t, annotations, 2)
+	assert.Equal(t, "HIGH", annotations[0].GetSeverity())
+	assert.Equal(t, "a2", annotations[1].GetExternalID())
+
+	assert.Equal(t, []map[string]interface{}{
+		{"external_id": "a1", "annotation_type": "CODE_SMELL", "severity": "HIGH", "path": "main.go", "line": float64(12)},
+		{"external_id": "a2"},
+	}, body)
+}
@@ -1,11 +1,26 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"time"
 )
 
+const (
+	// CommitStatusSuccessful is the state of a successful build.
+	CommitStatusSuccessful = "SUCCESSFUL"
+
+	// CommitStatusFailed is the state of a failed build.
+	CommitStatusFailed = "FAILED"
+
+	// CommitStatusInProgress is the state of a running build.
+	CommitStatusInProgress = "INPROGRESS"
+
+	// CommitStatusStopped is the state of a stopped build.
+	CommitStatusStopped = "STOPPED"
+)
+
 // CommitStatuses represent a collection of a commit's statuses.
 type CommitStatuses struct {
 	PaginationInfo
@@ -46,7 +61,7 @@ type CommitStatusRequest struct {
 // ListStatuses returns all statuses (e.g. build results) for a specific commit.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/commit/%7Bnode%7D/statuses#get
-func (c *CommitService) ListStatuses(owner, repoSlug, sha string, opts ...interface{}) (*CommitStatuses, *simpleresty.Response, error) {
+func (c *CommitService) ListStatuses(ctx context.Context, owner, repoSlug, sha string, opts ...interface{}) (*CommitStatuses, *simpleresty.Response, error) {
 	results := new(CommitStatuses)
 	urlStr, urlStrErr := c.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/commit/%s/statuses", owner, repoSlug, sha), opts...)
@@ -54,7 +69,7 @@ func (c *CommitService) ListStatuses(owner, repoSlug, sha string, opts ...interf
 		return nil, nil, urlStrErr
 	}
 
-	response, err := c.client.http.Get(urlStr, results, nil)
+	response, err := c.client.get(ctx, urlStr, results, nil)
 
 	return results, response, err
 }
@@ -62,10 +77,10 @@ func (c *CommitService) ListStatuses(owner, repoSlug, sha string, opts ...interf
 // CreateStatus creates a new build status against the specified commit.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/commit/%7Bnode%7D/statuses/build#post
-func (c *CommitService) CreateStatus(owner, repoSlug, sha string, co *CommitStatusRequest) (*CommitStatus, *simpleresty.Response, error) {
+func (c *CommitService) CreateStatus(ctx context.Context, owner, repoSlug, sha string, co *CommitStatusRequest) (*CommitStatus, *simpleresty.Response, error) {
 	results := new(CommitStatus)
 	urlStr := c.client.http.RequestURL("/repositories/%s/%s/commit/%s/statuses/build", owner, repoSlug, sha)
-	response, err := c.client.http.Post(urlStr, results, co)
+	response, err := c.client.post(ctx, urlStr, results, co)
 
 	return results, response, err
 }
@@ -73,10 +88,10 @@ func (c *CommitService) CreateStatus(owner, repoSlug, sha string, co *CommitStat
 // UpdateStatus update the current status of a build status object on the specific commit.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/commit/%7Bnode%7D/statuses/build/%7Bkey%7D#put
-func (c *CommitService) UpdateStatus(owner, repoSlug, sha, key string, co *CommitStatusRequest) (*CommitStatus, *simpleresty.Response, error) {
+func (c *CommitService) UpdateStatus(ctx context.Context, owner, repoSlug, sha, key string, co *CommitStatusRequest) (*CommitStatus, *simpleresty.Response, error) {
 	results := new(CommitStatus)
 	urlStr := c.client.http.RequestURL("/repositories/%s/%s/commit/%s/statuses/build/%s", owner, repoSlug, sha, key)
-	response, err := c.client.http.Put(urlStr, results, co)
+	response, err := c.client.put(ctx, urlStr, results, co)
 
 	return results, response, err
 }
@@ -84,7 +99,7 @@ func (c *CommitService) UpdateStatus(owner, repoSlug, sha, key string, co *Commi
 // GetStatusByBuild returns the specified build status for a commit.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/commit/%7Bnode%7D/statuses/build/%7Bkey%7D#get
-func (c *CommitService) GetStatusByBuild(owner, repoSlug, sha, key string, opts ...interface{}) (*CommitStatus, *simpleresty.Response, error) {
+func (c *CommitService) GetStatusByBuild(ctx context.Context, owner, repoSlug, sha, key string, opts ...interface{}) (*CommitStatus, *simpleresty.Response, error) {
 	results := new(CommitStatus)
 	urlStr, urlStrErr := c.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/commit/%s/statuses/build/%s", owner, repoSlug, sha, key), opts...)
@@ -92,7 +107,7 @@ func (c *CommitService) GetStatusByBuild(owner, repoSlug, sha, key string, opts
 		return nil, nil, urlStrErr
 	}
 
-	response, err := c.client.http.Get(urlStr, results, nil)
+	response, err := c.client.get(ctx, urlStr, results, nil)
 
 	return results, response, err
 }
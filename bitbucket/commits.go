@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -23,7 +24,7 @@ type Commits struct {
 // Supports filtering by passing in a non-URI encoded query string. Refer to the API docs below.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/commits#get
-func (c *CommitsService) List(owner, repoSlug string, opts ...interface{}) (*Commits, *simpleresty.Response, error) {
+func (c *CommitsService) List(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Commits, *simpleresty.Response, error) {
 	result := new(Commits)
 	urlStr, urlStrErr := c.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/commits", owner, repoSlug), opts...)
@@ -31,7 +32,7 @@ func (c *CommitsService) List(owner, repoSlug string, opts ...interface{}) (*Com
 		return nil, nil, urlStrErr
 	}
 
-	response, err := c.client.http.Get(urlStr, result, nil)
+	response, err := c.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -42,7 +43,7 @@ func (c *CommitsService) List(owner, repoSlug string, opts ...interface{}) (*Com
 // except that POST allows clients to place the include and exclude parameters in the request body to avoid URL length issues.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/commits/%7Brevision%7D#post
-func (c *CommitsService) ListSafe(owner, repoSlug string, opts ...interface{}) (*Commits, *simpleresty.Response, error) {
+func (c *CommitsService) ListSafe(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Commits, *simpleresty.Response, error) {
 	// TODO: The name of this function isn't that great. Feel free to suggest a new name and perhaps how this endpoint is suppose to work.
 	result := new(Commits)
 	urlStr, urlStrErr := c.client.http.RequestURLWithQueryParams(
@@ -51,7 +52,7 @@ func (c *CommitsService) ListSafe(owner, repoSlug string, opts ...interface{}) (
 		return nil, nil, urlStrErr
 	}
 
-	response, err := c.client.http.Post(urlStr, result, nil)
+	response, err := c.client.post(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -59,7 +60,7 @@ func (c *CommitsService) ListSafe(owner, repoSlug string, opts ...interface{}) (
 // GetRevision returns a commit revision. The results can return a collection of commits. Does not support any query parameters.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/commits/%7Brevision%7D#get
-func (c *CommitsService) GetRevision(owner, repoSlug, revision string, opts ...interface{}) (*Commits, *simpleresty.Response, error) {
+func (c *CommitsService) GetRevision(ctx context.Context, owner, repoSlug, revision string, opts ...interface{}) (*Commits, *simpleresty.Response, error) {
 	commits := new(Commits)
 	urlStr, urlStrErr := c.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/commits/%s", owner, repoSlug, revision), opts...)
@@ -67,7 +68,7 @@ func (c *CommitsService) GetRevision(owner, repoSlug, revision string, opts ...i
 		return nil, nil, urlStrErr
 	}
 
-	response, err := c.client.http.Get(urlStr, commits, nil)
+	response, err := c.client.get(ctx, urlStr, commits, nil)
 
 	return commits, response, err
 }
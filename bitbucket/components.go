@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -43,7 +44,7 @@ type ComponentRequest struct {
 // List all components that have been defined in the issue tracker.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/components#get
-func (c *ComponentsService) List(owner, repoSlug string, opts ...interface{}) (*Components, *simpleresty.Response, error) {
+func (c *ComponentsService) List(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Components, *simpleresty.Response, error) {
 	result := new(Components)
 	urlStr, urlStrErr := c.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/components", owner, repoSlug), opts...)
@@ -51,7 +52,7 @@ func (c *ComponentsService) List(owner, repoSlug string, opts ...interface{}) (*
 		return nil, nil, urlStrErr
 	}
 
-	response, err := c.client.http.Get(urlStr, result, nil)
+	response, err := c.client.get(ctx, urlStr, result, nil)
 
 	// Parse and store the component id
 	for _, component := range result.Values {
@@ -65,7 +66,7 @@ func (c *ComponentsService) List(owner, repoSlug string, opts ...interface{}) (*
 // NOTE: The component ID is a numerical value, not the component name, that is visible in the links.self.href object.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/components/%7Bcomponent_id%7D#get
-func (c *ComponentsService) Get(owner, repoSlug string, componentID int64, opts ...interface{}) (*Component, *simpleresty.Response, error) {
+func (c *ComponentsService) Get(ctx context.Context, owner, repoSlug string, componentID int64, opts ...interface{}) (*Component, *simpleresty.Response, error) {
 	component := new(Component)
 	urlStr, urlStrErr := c.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/components/%v", owner, repoSlug, componentID), opts...)
@@ -73,7 +74,7 @@ func (c *ComponentsService) Get(owner, repoSlug string, componentID int64, opts
 		return nil, nil, urlStrErr
 	}
 
-	response, err := c.client.http.Get(urlStr, component, nil)
+	response, err := c.client.get(ctx, urlStr, component, nil)
 
 	// Parse and store the component id
 	component.ID = parseForResourceID(componentSelfURLRegex, *component.Links.Self.HRef)
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -14,7 +15,7 @@ type DefaultReviewersService service
 // List returns the repository's default reviewers.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/default-reviewers#get
-func (dr *DefaultReviewersService) List(owner, repoSlug string, opts ...interface{}) (*Users, *simpleresty.Response, error) {
+func (dr *DefaultReviewersService) List(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Users, *simpleresty.Response, error) {
 	result := new(Users)
 	urlStr, urlStrErr := dr.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/default-reviewers", owner, repoSlug), opts...)
@@ -22,7 +23,7 @@ func (dr *DefaultReviewersService) List(owner, repoSlug string, opts ...interfac
 		return nil, nil, urlStrErr
 	}
 
-	response, err := dr.client.http.Get(urlStr, result, nil)
+	response, err := dr.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -34,7 +35,7 @@ func (dr *DefaultReviewersService) List(owner, repoSlug string, opts ...interfac
 // This can be used to test whether a user is among the repository's default reviewers list. A 404 indicates that that specified user is not a default reviewer.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/default-reviewers/%7Btarget_username%7D#get
-func (dr *DefaultReviewersService) Get(owner, repoSlug, userID string, opts ...interface{}) (*User, *simpleresty.Response, error) {
+func (dr *DefaultReviewersService) Get(ctx context.Context, owner, repoSlug, userID string, opts ...interface{}) (*User, *simpleresty.Response, error) {
 	result := new(User)
 	urlStr, urlStrErr := dr.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/default-reviewers/%s", owner, repoSlug, userID), opts...)
@@ -42,7 +43,7 @@ func (dr *DefaultReviewersService) Get(owner, repoSlug, userID string, opts ...i
 		return nil, nil, urlStrErr
 	}
 
-	response, err := dr.client.http.Get(urlStr, result, nil)
+	response, err := dr.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -53,10 +54,10 @@ func (dr *DefaultReviewersService) Get(owner, repoSlug, userID string, opts ...i
 // Accepts the user's UUID, account_id, or username. Recommend to use UUID or account_id.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/default-reviewers/%7Btarget_username%7D#put
-func (dr *DefaultReviewersService) Add(owner, repoSlug, userID string) (*User, *simpleresty.Response, error) {
+func (dr *DefaultReviewersService) Add(ctx context.Context, owner, repoSlug, userID string) (*User, *simpleresty.Response, error) {
 	result := new(User)
 	urlStr := dr.client.http.RequestURL("/repositories/%s/%s/default-reviewers/%s", owner, repoSlug, userID)
-	response, err := dr.client.http.Put(urlStr, result, nil)
+	response, err := dr.client.put(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -67,9 +68,9 @@ func (dr *DefaultReviewersService) Add(owner, repoSlug, userID string) (*User, *
 // Accepts the user's UUID, account_id, or username. Recommend to use UUID or account_id.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/default-reviewers/%7Btarget_username%7D#delete
-func (dr *DefaultReviewersService) Remove(owner, repoSlug, userID string) (*simpleresty.Response, error) {
+func (dr *DefaultReviewersService) Remove(ctx context.Context, owner, repoSlug, userID string) (*simpleresty.Response, error) {
 	urlStr := dr.client.http.RequestURL("/repositories/%s/%s/default-reviewers/%s", owner, repoSlug, userID)
-	response, err := dr.client.http.Delete(urlStr, nil, nil)
+	response, err := dr.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
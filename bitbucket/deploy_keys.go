@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"time"
@@ -47,7 +48,7 @@ type DeployKeyRequest struct {
 // List returns all deploy-keys belonging to a repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/deploy-keys#get
-func (dk *DeployKeysService) List(owner, repoSlug string, opts ...interface{}) (*DeployKeys, *simpleresty.Response, error) {
+func (dk *DeployKeysService) List(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*DeployKeys, *simpleresty.Response, error) {
 	result := new(DeployKeys)
 	urlStr, urlStrErr := dk.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/deploy-keys", owner, repoSlug), opts...)
@@ -55,7 +56,7 @@ func (dk *DeployKeysService) List(owner, repoSlug string, opts ...interface{}) (
 		return nil, nil, urlStrErr
 	}
 
-	response, err := dk.client.http.Get(urlStr, result, nil)
+	response, err := dk.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -63,10 +64,10 @@ func (dk *DeployKeysService) List(owner, repoSlug string, opts ...interface{}) (
 // Add creates a new deploy key in a repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/deploy-keys#post
-func (dk *DeployKeysService) Add(owner, repoSlug string, do *DeployKeyRequest) (*DeployKey, *simpleresty.Response, error) {
+func (dk *DeployKeysService) Add(ctx context.Context, owner, repoSlug string, do *DeployKeyRequest) (*DeployKey, *simpleresty.Response, error) {
 	result := new(DeployKey)
 	urlStr := dk.client.http.RequestURL("/repositories/%s/%s/deploy-keys", owner, repoSlug)
-	response, err := dk.client.http.Post(urlStr, result, do)
+	response, err := dk.client.post(ctx, urlStr, result, do)
 
 	return result, response, err
 }
@@ -74,7 +75,7 @@ func (dk *DeployKeysService) Add(owner, repoSlug string, do *DeployKeyRequest) (
 // Get returns the deploy key belonging to a specific key.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/deploy-keys/%7Bkey_id%7D#get
-func (dk *DeployKeysService) Get(owner, repoSlug string, keyID int64, opts ...interface{}) (*DeployKey, *simpleresty.Response, error) {
+func (dk *DeployKeysService) Get(ctx context.Context, owner, repoSlug string, keyID int64, opts ...interface{}) (*DeployKey, *simpleresty.Response, error) {
 	result := new(DeployKey)
 	urlStr, urlStrErr := dk.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/deploy-keys/%v", owner, repoSlug, keyID), opts...)
@@ -82,7 +83,7 @@ func (dk *DeployKeysService) Get(owner, repoSlug string, keyID int64, opts ...in
 		return nil, nil, urlStrErr
 	}
 
-	response, err := dk.client.http.Get(urlStr, result, nil)
+	response, err := dk.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -93,10 +94,10 @@ func (dk *DeployKeysService) Get(owner, repoSlug string, keyID int64, opts ...in
 // For security reasons, you can't modify the contents of an access key. To update, delete and re-add the key.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/deploy-keys/%7Bkey_id%7D#put
-func (dk *DeployKeysService) Update(owner, repoSlug string, keyID int64, do *DeployKeyRequest) (*DeployKey, *simpleresty.Response, error) {
+func (dk *DeployKeysService) Update(ctx context.Context, owner, repoSlug string, keyID int64, do *DeployKeyRequest) (*DeployKey, *simpleresty.Response, error) {
 	result := new(DeployKey)
 	urlStr := dk.client.http.RequestURL("/repositories/%s/%s/deploy-keys/%v", owner, repoSlug, keyID)
-	response, err := dk.client.http.Put(urlStr, result, do)
+	response, err := dk.client.put(ctx, urlStr, result, do)
 
 	return result, response, err
 }
@@ -104,9 +105,9 @@ func (dk *DeployKeysService) Update(owner, repoSlug string, keyID int64, do *Dep
 // Remove deletes a deploy key from a repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/deploy-keys/%7Bkey_id%7D#delete
-func (dk *DeployKeysService) Remove(owner, repoSlug string, keyID int64) (*simpleresty.Response, error) {
+func (dk *DeployKeysService) Remove(ctx context.Context, owner, repoSlug string, keyID int64) (*simpleresty.Response, error) {
 	urlStr := dk.client.http.RequestURL("/repositories/%s/%s/deploy-keys/%v", owner, repoSlug, keyID)
-	response, err := dk.client.http.Delete(urlStr, nil, nil)
+	response, err := dk.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
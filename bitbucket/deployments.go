@@ -0,0 +1,272 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/bitbucket-go/bql"
+	"github.com/davidji99/simpleresty"
+	"time"
+)
+
+// DeploymentsService handles communication with the deployments and environments related methods
+// of the Bitbucket API.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-deployments/
+type DeploymentsService service
+
+const (
+	// EnvironmentTypeTest is the environment type of test environments.
+	EnvironmentTypeTest = "Test"
+
+	// EnvironmentTypeStaging is the environment type of staging environments.
+	EnvironmentTypeStaging = "Staging"
+
+	// EnvironmentTypeProduction is the environment type of production environments.
+	EnvironmentTypeProduction = "Production"
+)
+
+// Deployments represents a collection of deployments.
+type Deployments struct {
+	PaginationInfo
+
+	Values []*Deployment `json:"values,omitempty"`
+}
+
+// Deployment represents the deployment of a release to an environment.
+type Deployment struct {
+	Type        *string                `json:"type,omitempty"`
+	UUID        *string                `json:"uuid,omitempty"`
+	State       *DeploymentState       `json:"state,omitempty"`
+	Environment *Environment           `json:"environment,omitempty"`
+	Release     *DeploymentRelease     `json:"release,omitempty"`
+	Deployable  *DeploymentDeployable  `json:"deployable,omitempty"`
+	LastUpdate  *time.Time             `json:"last_update_time,omitempty"`
+	Number      *int64                 `json:"number,omitempty"`
+	Key         *string                `json:"key,omitempty"`
+	Step        *PipelineStepReference `json:"step,omitempty"`
+}
+
+// DeploymentState represents the state of a deployment.
+//
+// Name is one of UNDEPLOYED, IN_PROGRESS or COMPLETED. Once completed, Status holds the outcome such as SUCCESSFUL.
+type DeploymentState struct {
+	Type        *string                `json:"type,omitempty"`
+	Name        *string                `json:"name,omitempty"`
+	Status      *DeploymentStateStatus `json:"status,omitempty"`
+	URL         *string                `json:"url,omitempty"`
+	Deployer    *User                  `json:"deployer,omitempty"`
+	StartedOn   *time.Time             `json:"started_on,omitempty"`
+	CompletedOn *time.Time             `json:"completed_on,omitempty"`
+}
+
+// DeploymentStateStatus represents the outcome of a completed deployment.
+type DeploymentStateStatus struct {
+	Type *string `json:"type,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// DeploymentRelease represents the release, and thereby the commit, that was deployed.
+type DeploymentRelease struct {
+	Type      *string                `json:"type,omitempty"`
+	UUID      *string                `json:"uuid,omitempty"`
+	Name      *string                `json:"name,omitempty"`
+	URL       *string                `json:"url,omitempty"`
+	Commit    *Commit                `json:"commit,omitempty"`
+	Pipeline  *PipelineStepReference `json:"pipeline,omitempty"`
+	CreatedOn *time.Time             `json:"created_on,omitempty"`
+}
+
+// DeploymentDeployable represents the build artifact of a deployment.
+type DeploymentDeployable struct {
+	Type      *string                `json:"type,omitempty"`
+	UUID      *string                `json:"uuid,omitempty"`
+	Name      *string                `json:"name,omitempty"`
+	URL       *string                `json:"url,omitempty"`
+	Commit    *Commit                `json:"commit,omitempty"`
+	Pipeline  *PipelineStepReference `json:"pipeline,omitempty"`
+	CreatedOn *time.Time             `json:"created_on,omitempty"`
+}
+
+// Environments represents a collection of deployment environments.
+type Environments struct {
+	PaginationInfo
+
+	Values []*Environment `json:"values,omitempty"`
+}
+
+// Environment represents a deployment environment of a repository.
+type Environment struct {
+	Type                   *string                  `json:"type,omitempty"`
+	UUID                   *string                  `json:"uuid,omitempty"`
+	Name                   *string                  `json:"name,omitempty"`
+	Slug                   *string                  `json:"slug,omitempty"`
+	Rank                   *int64                   `json:"rank,omitempty"`
+	Hidden                 *bool                    `json:"hidden,omitempty"`
+	EnvironmentType        *EnvironmentType         `json:"environment_type,omitempty"`
+	Restrictions           *EnvironmentRestrictions `json:"restrictions,omitempty"`
+	Lock                   *EnvironmentLock         `json:"lock,omitempty"`
+	EnvironmentLockEnabled *bool                    `json:"environment_lock_enabled,omitempty"`
+	DeploymentGateEnabled  *bool                    `json:"deployment_gate_enabled,omitempty"`
+}
+
+// EnvironmentType represents the type of an environment, one of EnvironmentTypeTest,
+// EnvironmentTypeStaging or EnvironmentTypeProduction.
+type EnvironmentType struct {
+	Type *string `json:"type,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Rank *int64  `json:"rank,omitempty"`
+}
+
+// EnvironmentRestrictions represents who may deploy to an environment.
+type EnvironmentRestrictions struct {
+	Type      *string `json:"type,omitempty"`
+	AdminOnly *bool   `json:"admin_only,omitempty"`
+}
+
+// EnvironmentLock represents the lock that prevents concurrent deployments to an environment.
+type EnvironmentLock struct {
+	Type *string `json:"type,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// EnvironmentRequest represents a request to create an environment.
+type EnvironmentRequest struct {
+	Name            *string          `json:"name,omitempty"`
+	EnvironmentType *EnvironmentType `json:"environment_type,omitempty"`
+	Rank            *int64           `json:"rank,omitempty"`
+}
+
+// EnvironmentUpdateRequest represents the changes to apply to an environment.
+// Only the fields that are set are changed.
+type EnvironmentUpdateRequest struct {
+	Name                   *string                  `json:"name,omitempty"`
+	Rank                   *int64                   `json:"rank,omitempty"`
+	Hidden                 *bool                    `json:"hidden,omitempty"`
+	Restrictions           *EnvironmentRestrictions `json:"restrictions,omitempty"`
+	EnvironmentLockEnabled *bool                    `json:"environment_lock_enabled,omitempty"`
+	DeploymentGateEnabled  *bool                    `json:"deployment_gate_enabled,omitempty"`
+}
+
+// List returns the deployments of a repository.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-deployments/#api-repositories-workspace-repo-slug-deployments-get
+func (d *DeploymentsService) List(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Deployments, *simpleresty.Response, error) {
+	result := new(Deployments)
+	urlStr, urlStrErr := d.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/deployments/", owner, repoSlug), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := d.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// ListByEnvironment returns the deployments of a repository to a single environment.
+//
+// The environment filter is sent as the "q" query parameter. A FilterSortOpts.Query in opts is combined with it using AND.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-deployments/#api-repositories-workspace-repo-slug-deployments-get
+func (d *DeploymentsService) ListByEnvironment(ctx context.Context, owner, repoSlug, environmentUUID string, opts ...interface{}) (*Deployments, *simpleresty.Response, error) {
+	query := bql.Field("environment.uuid").Eq(bql.UUID(environmentUUID)).String()
+
+	// Query parameters are merged with the last value winning, so only the last caller query is kept.
+	var callerQuery string
+	for _, opt := range opts {
+		if o, ok := opt.(*FilterSortOpts); ok && o != nil && o.Query != "" {
+			callerQuery = o.Query
+		}
+	}
+	if callerQuery != "" {
+		query = "(" + callerQuery + ") AND " + query
+	}
+
+	opts = append(opts, &FilterSortOpts{Query: query})
+
+	return d.List(ctx, owner, repoSlug, opts...)
+}
+
+// Get returns a single deployment, including its release and commit.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-deployments/#api-repositories-workspace-repo-slug-deployments-deployment-uuid-get
+func (d *DeploymentsService) Get(ctx context.Context, owner, repoSlug, deploymentUUID string, opts ...interface{}) (*Deployment, *simpleresty.Response, error) {
+	result := new(Deployment)
+	urlStr, urlStrErr := d.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/deployments/%s", owner, repoSlug, deploymentUUID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := d.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// ListEnvironments returns the deployment environments of a repository.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-deployments/#api-repositories-workspace-repo-slug-environments-get
+func (d *DeploymentsService) ListEnvironments(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Environments, *simpleresty.Response, error) {
+	result := new(Environments)
+	urlStr, urlStrErr := d.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/environments/", owner, repoSlug), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := d.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// GetEnvironment returns a single deployment environment.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-deployments/#api-repositories-workspace-repo-slug-environments-environment-uuid-get
+func (d *DeploymentsService) GetEnvironment(ctx context.Context, owner, repoSlug, environmentUUID string, opts ...interface{}) (*Environment, *simpleresty.Response, error) {
+	result := new(Environment)
+	urlStr, urlStrErr := d.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/environments/%s", owner, repoSlug, environmentUUID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := d.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// CreateEnvironment creates a deployment environment.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-deployments/#api-repositories-workspace-repo-slug-environments-post
+func (d *DeploymentsService) CreateEnvironment(ctx context.Context, owner, repoSlug string, eo *EnvironmentRequest) (*Environment, *simpleresty.Response, error) {
+	result := new(Environment)
+	urlStr := d.client.http.RequestURL("/repositories/%s/%s/environments/", owner, repoSlug)
+	response, err := d.client.post(ctx, urlStr, result, eo)
+
+	return result, response, err
+}
+
+// UpdateEnvironment applies changes, such as the name, lock or admin-only restriction, to a deployment environment.
+//
+// Bitbucket accepts the changes with a 202 and applies them asynchronously, so no environment is returned.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-deployments/#api-repositories-workspace-repo-slug-environments-environment-uuid-changes-post
+func (d *DeploymentsService) UpdateEnvironment(ctx context.Context, owner, repoSlug, environmentUUID string, eo *EnvironmentUpdateRequest) (*simpleresty.Response, error) {
+	urlStr := d.client.http.RequestURL("/repositories/%s/%s/environments/%s/changes/", owner, repoSlug, environmentUUID)
+	response, err := d.client.post(ctx, urlStr, nil, eo)
+	if IsAccepted(err) {
+		err = nil
+	}
+
+	return response, err
+}
+
+// DeleteEnvironment deletes a deployment environment.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-deployments/#api-repositories-workspace-repo-slug-environments-environment-uuid-delete
+func (d *DeploymentsService) DeleteEnvironment(ctx context.Context, owner, repoSlug, environmentUUID string) (*simpleresty.Response, error) {
+	urlStr := d.client.http.RequestURL("/repositories/%s/%s/environments/%s", owner, repoSlug, environmentUUID)
+	response, err := d.client.delete(ctx, urlStr, nil, nil)
+
+	return response, err
+}
@@ -0,0 +1,114 @@
+package bitbucket
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeploymentsService_List(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusOK, `{"pagelen":1,"values":[{
+		"uuid":"{d-1}",
+		"state":{"name":"COMPLETED","status":{"name":"SUCCESSFUL"}},
+		"environment":{"uuid":"{e-1}"},
+		"release":{"name":"release-7","commit":{"hash":"abc123"}}
+	}]}`)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	deployments, _, listErr := client.Deployments.List(ctx, "owner", "repo")
+	assert.Nil(t, listErr)
+	assert.Equal(t, "GET", captured.Method)
+	assert.Equal(t, "/repositories/owner/repo/deployments/", captured.Path)
+	assert.Len(t, deployments.Values, 1)
+
+	d := deployments.Values[0]
+	assert.Equal(t, "SUCCESSFUL", d.GetState().GetStatus().GetName())
+	assert.Equal(t, "{e-1}", d.GetEnvironment().GetUUID())
+	assert.Equal(t, "abc123", d.GetRelease().GetCommit().GetHash())
+
+	_, _, listErr = client.Deployments.ListByEnvironment(ctx, "owner", "repo", "{e-1}", &ListOpts{Pagelen: 10})
+	assert.Nil(t, listErr)
+	assert.Equal(t, "/repositories/owner/repo/deployments/", captured.Path)
+	assert.Equal(t, url.Values{"pagelen": {"10"}, "q": {`environment.uuid = "{e-1}"`}}.Encode(), captured.Query)
+
+	// A caller query is kept alongside the environment filter, as is its sort.
+	_, _, listErr = client.Deployments.ListByEnvironment(ctx, "owner", "repo", "e-1",
+		&FilterSortOpts{Query: `state.name = "COMPLETED" OR state.name = "IN_PROGRESS"`, Sort: "-created_on"})
+	assert.Nil(t, listErr)
+	assert.Equal(t, url.Values{
+		"q":    {`(state.name = "COMPLETED" OR state.name = "IN_PROGRESS") AND environment.uuid = "{e-1}"`},
+		"sort": {"-created_on"},
+	}.Encode(), captured.Query)
+}
+
+func TestDeploymentsService_Environments(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusCreated, `{"uuid":"{e-1}","name":"Staging",
+		"environment_type":{"name":"Staging","rank":1},"restrictions":{"admin_only":true}}`)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	_, _, listErr := client.Deployments.ListEnvironments(ctx, "owner", "repo")
+	assert.Nil(t, listErr)
+	assert.Equal(t, "GET", captured.Method)
+	assert.Equal(t, "/repositories/owner/repo/environments/", captured.Path)
+
+	name, envType := "Staging", EnvironmentTypeStaging
+	env, _, createErr := client.Deployments.CreateEnvironment(ctx, "owner", "repo", &EnvironmentRequest{
+		Name:            &name,
+		EnvironmentType: &EnvironmentType{Name: &envType},
+	})
+	assert.Nil(t, createErr)
+	assert.True(t, env.GetRestrictions().GetAdminOnly())
+	assert.Equal(t, capturedRequest{Method: "POST", Path: "/repositories/owner/repo/environments/",
+		Body: map[string]interface{}{"name": "Staging", "environment_type": map[string]interface{}{"name": "Staging"}}}, captured)
+
+	_, deleteErr := client.Deployments.DeleteEnvironment(ctx, "owner", "repo", "{e-1}")
+	assert.Nil(t, deleteErr)
+	assert.Equal(t, "DELETE", captured.Method)
+	assert.Equal(t, "/repositories/owner/repo/environments/{e-1}", captured.Path)
+}
+
+func TestDeploymentsService_UpdateEnvironment(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusAccepted, "")
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	name, adminOnly := "Production", true
+	response, updateErr := client.Deployments.UpdateEnvironment(context.Background(), "owner", "repo", "{e-1}",
+		&EnvironmentUpdateRequest{Name: &name, Restrictions: &EnvironmentRestrictions{AdminOnly: &adminOnly}})
+
+	// The empty 202 Bitbucket replies with is not reported as an error.
+	assert.Nil(t, updateErr)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+	assert.Equal(t, capturedRequest{Method: "POST", Path: "/repositories/owner/repo/environments/{e-1}/changes/",
+		Body: map[string]interface{}{"name": "Production", "restrictions": map[string]interface{}{"admin_only": true}}}, captured)
+}
+
+func TestDeploymentsService_UpdateEnvironmentError(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusBadRequest, `{"type":"error","error":{"message":"Invalid rank"}}`)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	rank := int64(-1)
+	_, updateErr := client.Deployments.UpdateEnvironment(context.Background(), "owner", "repo", "{e-1}",
+		&EnvironmentUpdateRequest{Rank: &rank})
+	assert.True(t, IsBadRequest(updateErr))
+}
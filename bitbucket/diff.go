@@ -2,6 +2,7 @@ package bitbucket
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -19,14 +20,101 @@ type Diffs struct {
 	Values []*Diff `json:"values,omitempty"`
 }
 
+// DiffStatus represents how a file changed in a diffstat.
+type DiffStatus string
+
+const (
+	// DiffStatusAdded is the status of a file that was added.
+	DiffStatusAdded DiffStatus = "added"
+
+	// DiffStatusRemoved is the status of a file that was removed.
+	DiffStatusRemoved DiffStatus = "removed"
+
+	// DiffStatusModified is the status of a file whose content or mode changed.
+	DiffStatusModified DiffStatus = "modified"
+
+	// DiffStatusRenamed is the status of a file that was moved, possibly with changes.
+	DiffStatusRenamed DiffStatus = "renamed"
+
+	// DiffStatusMergeConflict is the status of a file both sides of a merge changed in conflicting ways.
+	DiffStatusMergeConflict DiffStatus = "merge conflict"
+
+	// DiffStatusLocalDeleted is the status of a file the destination removed while the source changed it.
+	DiffStatusLocalDeleted DiffStatus = "local deleted"
+
+	// DiffStatusRemoteDeleted is the status of a file the source removed while the destination changed it.
+	DiffStatusRemoteDeleted DiffStatus = "remote deleted"
+)
+
 // Diff represents a code diff on Bitbucket.
 type Diff struct {
-	Status       *string   `json:"state,omitempty"`
-	Old          *CodeFile `json:"old,omitempty"`
-	New          *CodeFile `json:"new,omitempty"`
-	LinesRemoved *int64    `json:"lines_removed,omitempty"`
-	LinesAdded   *int64    `json:"lines_added,omitempty"`
-	Type         *string   `json:"type,omitempty"`
+	Status       *DiffStatus `json:"status,omitempty"`
+	Old          *CodeFile   `json:"old,omitempty"`
+	New          *CodeFile   `json:"new,omitempty"`
+	LinesRemoved *int64      `json:"lines_removed,omitempty"`
+	LinesAdded   *int64      `json:"lines_added,omitempty"`
+	Type         *string     `json:"type,omitempty"`
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (d *Diff) GetStatus() DiffStatus {
+	if d == nil || d.Status == nil {
+		return ""
+	}
+	return *d.Status
+}
+
+// IsConflict reports whether the file could not be merged cleanly, as is the case for a pull request
+// whose source and destination branches conflict.
+func (d *Diff) IsConflict() bool {
+	switch d.GetStatus() {
+	case DiffStatusMergeConflict, DiffStatusLocalDeleted, DiffStatusRemoteDeleted:
+		return true
+	}
+
+	return false
+}
+
+// DiffStatTotals represents the totals of a diffstat.
+type DiffStatTotals struct {
+	Files        int64
+	LinesAdded   int64
+	LinesRemoved int64
+
+	// Conflicts is the number of files that could not be merged cleanly.
+	Conflicts int64
+}
+
+// NewDiffStatTotals adds up the entries of a diffstat. To include every page, pass the values collected by
+// a Pager or use DiffService.GetTotals and PullRequestsService.GetDiffTotals.
+func NewDiffStatTotals(diffs []*Diff) *DiffStatTotals {
+	totals := &DiffStatTotals{}
+	for _, d := range diffs {
+		totals.Files++
+		totals.LinesAdded += d.GetLinesAdded()
+		totals.LinesRemoved += d.GetLinesRemoved()
+		if d.IsConflict() {
+			totals.Conflicts++
+		}
+	}
+
+	return totals
+}
+
+// DiffStatOpts represents the query parameters available when getting a diffstat.
+type DiffStatOpts struct {
+	// IgnoreWhitespace ignores changes that only alter whitespace.
+	IgnoreWhitespace *bool `url:"ignore_whitespace,omitempty"`
+
+	// Merge diffs against the merge base of the two commits of a revspec instead of the first commit itself.
+	// Bitbucket defaults to true.
+	Merge *bool `url:"merge,omitempty"`
+
+	// Path limits the diffstat to the given paths. It may be repeated.
+	Path []string `url:"path,omitempty"`
+
+	// Renames detects renamed files. Bitbucket defaults to true.
+	Renames *bool `url:"renames,omitempty"`
 }
 
 // DiffGetOpts represents the query parameters available when getting the raw of a diff.
@@ -41,29 +129,29 @@ type DiffGetOpts struct {
 // or a revspec of 2 commits (e.g. 3a8b42..9ff173 where the first commit represents the source and the second commit the destination).
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/diff/%7Bspec%7D#get
-func (d *DiffService) GetRaw(owner, repoSlug, spec string) (*bytes.Buffer, *simpleresty.Response, error) {
+func (d *DiffService) GetRaw(ctx context.Context, owner, repoSlug, spec string) (*bytes.Buffer, *simpleresty.Response, error) {
 	urlStr := d.client.http.RequestURL("/repositories/%s/%s/diff/%s", owner, repoSlug, spec)
 
-	var buff bytes.Buffer
-	req := d.client.http.NewRequest()
+	req := d.client.newRequest(ctx)
 	req.Method = simpleresty.GetMethod
 	req.URL = urlStr
-	req.Result = &buff
+	req.SetHeader("Accept", "text/plain")
 
-	response, reqErr := d.client.http.Dispatch(req)
+	response, reqErr := d.client.dispatch(req)
 	if reqErr != nil {
-		return nil, nil, reqErr
+		return nil, response, reqErr
 	}
 
-	return &buff, response, nil
+	return bytes.NewBuffer(response.Resp.Body()), response, nil
 }
 
 // Get returns the diff stat for the specified commit.
 //
 // Diff stat responses contain a record for every path modified by the commit and lists the number of lines added and removed for each file.
+// Use DiffStatOpts to ignore whitespace, disable rename detection or limit the diffstat to some paths.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/diffstat/%7Bspec%7D#get
-func (d *DiffService) Get(owner, repoSlug, spec string, opts ...interface{}) (*Diffs, *simpleresty.Response, error) {
+func (d *DiffService) Get(ctx context.Context, owner, repoSlug, spec string, opts ...interface{}) (*Diffs, *simpleresty.Response, error) {
 	result := new(Diffs)
 	urlStr, urlStrErr := d.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/diffstat/%s", owner, repoSlug, spec), opts...)
@@ -71,7 +159,19 @@ func (d *DiffService) Get(owner, repoSlug, spec string, opts ...interface{}) (*D
 		return nil, nil, urlStrErr
 	}
 
-	response, err := d.client.http.Get(urlStr, result, nil)
+	response, err := d.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
+
+// GetTotals returns the totals of the diff stat for the specified commit, following every page.
+func (d *DiffService) GetTotals(ctx context.Context, owner, repoSlug, spec string, opts ...interface{}) (*DiffStatTotals, error) {
+	diffs, err := NewPager[*Diff](d.client, func(ctx context.Context, opts ...interface{}) (*Diffs, *simpleresty.Response, error) {
+		return d.Get(ctx, owner, repoSlug, spec, opts...)
+	}, opts...).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDiffStatTotals(diffs), nil
+}
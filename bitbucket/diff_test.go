@@ -0,0 +1,80 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff_Unmarshal(t *testing.T) {
+	var d Diff
+	err := json.Unmarshal([]byte(`{
+		"type": "diffstat",
+		"status": "renamed",
+		"lines_added": 3,
+		"lines_removed": 1,
+		"old": {"path": "old name.go", "escaped_path": "old%20name.go", "type": "commit_file",
+			"commit": {"hash": "abc123"}},
+		"new": {"path": "new name.go", "escaped_path": "new%20name.go", "type": "commit_file",
+			"commit": {"hash": "def456"}, "links": {"self": {"href": "https://api.bitbucket.org/2.0/src/def456/new%20name.go"}}}
+	}`), &d)
+	assert.Nil(t, err)
+
+	assert.Equal(t, DiffStatusRenamed, d.GetStatus())
+	assert.False(t, d.IsConflict())
+	assert.Equal(t, "old%20name.go", d.GetOld().GetEscapedPath())
+	assert.Equal(t, "abc123", d.GetOld().GetCommit().GetHash())
+	assert.Equal(t, "def456", d.GetNew().GetCommit().GetHash())
+	assert.Equal(t, "https://api.bitbucket.org/2.0/src/def456/new%20name.go", d.GetNew().GetLinks().GetSelf().GetHRef())
+}
+
+func TestNewDiffStatTotals(t *testing.T) {
+	diff := func(status DiffStatus, added, removed int64) *Diff {
+		return &Diff{Status: &status, LinesAdded: &added, LinesRemoved: &removed}
+	}
+
+	totals := NewDiffStatTotals([]*Diff{
+		diff(DiffStatusAdded, 10, 0),
+		diff(DiffStatusModified, 2, 3),
+		diff(DiffStatusMergeConflict, 1, 1),
+		diff(DiffStatusRemoteDeleted, 0, 0),
+	})
+
+	assert.Equal(t, &DiffStatTotals{Files: 4, LinesAdded: 13, LinesRemoved: 4, Conflicts: 2}, totals)
+	assert.Equal(t, &DiffStatTotals{}, NewDiffStatTotals(nil))
+}
+
+func TestPullRequestsService_GetDiffTotals(t *testing.T) {
+	var serverURL string
+	var query []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`{"values":[{"status":"merge conflict","lines_added":1,"lines_removed":2}]}`))
+			return
+		}
+
+		query = append(query, r.URL.RawQuery)
+		fmt.Fprintf(w, `{"values":[{"status":"modified","lines_added":4,"lines_removed":0}],"next":"%s/repositories/owner/repo/pullrequests/1/diffstat?page=2"}`, serverURL)
+	}))
+	defer server.Close()
+	serverURL = server.URL
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	ignore, renames := true, false
+	totals, totalsErr := client.PullRequests.GetDiffTotals(context.Background(), "owner", "repo", 1, &DiffStatOpts{
+		IgnoreWhitespace: &ignore,
+		Renames:          &renames,
+		Path:             []string{"a.go", "b.go"},
+	})
+	assert.Nil(t, totalsErr)
+	assert.Equal(t, &DiffStatTotals{Files: 2, LinesAdded: 5, LinesRemoved: 2, Conflicts: 1}, totals)
+	assert.Equal(t, []string{"ignore_whitespace=true&path=a.go&path=b.go&renames=false"}, query)
+}
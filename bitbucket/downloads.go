@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"net/url"
@@ -37,7 +38,7 @@ type ArtifactFileLinks struct {
 // List returns a list of download links associated with the repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/downloads#get
-func (d *DownloadsService) List(owner, repoSlug string, opts ...interface{}) (*Artifacts, *simpleresty.Response, error) {
+func (d *DownloadsService) List(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Artifacts, *simpleresty.Response, error) {
 	downloads := new(Artifacts)
 	urlStr, urlStrErr := d.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/downloads", owner, repoSlug), opts...)
@@ -45,7 +46,7 @@ func (d *DownloadsService) List(owner, repoSlug string, opts ...interface{}) (*A
 		return nil, nil, urlStrErr
 	}
 
-	response, err := d.client.http.Get(urlStr, downloads, nil)
+	response, err := d.client.get(ctx, urlStr, downloads, nil)
 
 	return downloads, response, err
 }
@@ -53,10 +54,10 @@ func (d *DownloadsService) List(owner, repoSlug string, opts ...interface{}) (*A
 // Delete the specified download artifact from the repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/downloads/%7Bfilename%7D#delete
-func (d *DownloadsService) Delete(owner, repoSlug, fileName string) (*simpleresty.Response, error) {
+func (d *DownloadsService) Delete(ctx context.Context, owner, repoSlug, fileName string) (*simpleresty.Response, error) {
 	escapedFilename := url.QueryEscape(fileName)
 	urlStr := d.client.http.RequestURL("/repositories/%s/%s/downloads/%s", owner, repoSlug, escapedFilename)
-	response, err := d.client.http.Delete(urlStr, nil, nil)
+	response, err := d.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
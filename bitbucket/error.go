@@ -5,6 +5,17 @@
 
 package bitbucket
 
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/davidji99/simpleresty"
+	"github.com/go-resty/resty/v2"
+)
+
 // AcceptedError occurs when Bitbucket returns 202 Accepted response with an
 // empty body, which means a job was scheduled on the Bitbucket side to process
 // the information needed and cache it.
@@ -19,3 +30,192 @@ type AcceptedError struct {
 func (*AcceptedError) Error() string {
 	return "job scheduled on Bitbucket side; try again later"
 }
+
+// ErrorResponse reports an error caused by an API request.
+type ErrorResponse struct {
+	// Response is the HTTP response that caused this error.
+	Response *simpleresty.Response `json:"-"`
+
+	// StatusCode is the HTTP status code of the response such as 404.
+	StatusCode int `json:"-"`
+
+	// Method is the HTTP method of the request such as GET.
+	Method string `json:"-"`
+
+	// URL is the request URL.
+	URL string `json:"-"`
+
+	// Type is the object type returned by Bitbucket, which is always "error" for a well-formed error body.
+	Type string `json:"type,omitempty"`
+
+	// Detail contains the decoded Bitbucket error. It is nil if the response body was not a Bitbucket error object.
+	Detail *ErrorDetail `json:"error,omitempty"`
+}
+
+// ErrorDetail represents the "error" object in a Bitbucket error response.
+type ErrorDetail struct {
+	Message string `json:"message,omitempty"`
+	Detail  string `json:"detail,omitempty"`
+
+	// Fields maps an invalid request field to its error messages.
+	Fields map[string][]string `json:"fields,omitempty"`
+
+	// Data holds optional endpoint-specific structured data.
+	Data map[string]interface{} `json:"data,omitempty"`
+}
+
+// UnmarshalJSON decodes an error detail. Bitbucket returns field errors either as a single string
+// or as a list of strings, so both forms are normalized into Fields.
+func (e *ErrorDetail) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Message string                     `json:"message,omitempty"`
+		Detail  string                     `json:"detail,omitempty"`
+		Fields  map[string]json.RawMessage `json:"fields,omitempty"`
+		Data    map[string]interface{}     `json:"data,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	e.Message = raw.Message
+	e.Detail = raw.Detail
+	e.Data = raw.Data
+	e.Fields = nil
+
+	if len(raw.Fields) > 0 {
+		e.Fields = make(map[string][]string, len(raw.Fields))
+	}
+	for k, v := range raw.Fields {
+		var list []string
+		if json.Unmarshal(v, &list) == nil {
+			e.Fields[k] = list
+			continue
+		}
+
+		var single string
+		if json.Unmarshal(v, &single) == nil {
+			e.Fields[k] = []string{single}
+			continue
+		}
+
+		e.Fields[k] = []string{string(v)}
+	}
+
+	return nil
+}
+
+func (e *ErrorResponse) Error() string {
+	msg := ""
+	if e.Response != nil {
+		msg = e.Response.Body
+	}
+
+	if e.Detail != nil && e.Detail.Message != "" {
+		msg = e.Detail.Message
+		if e.Detail.Detail != "" {
+			msg += ": " + e.Detail.Detail
+		}
+	}
+
+	return fmt.Sprintf("%s %s: %d %s", e.Method, e.URL, e.StatusCode, msg)
+}
+
+// Message returns the Bitbucket error message, or an empty string if there is none.
+func (e *ErrorResponse) Message() string {
+	if e.Detail == nil {
+		return ""
+	}
+	return e.Detail.Message
+}
+
+// Fields returns the per-field validation errors, or nil if there are none.
+func (e *ErrorResponse) Fields() map[string][]string {
+	if e.Detail == nil {
+		return nil
+	}
+	return e.Detail.Fields
+}
+
+// checkResponse parses the HTTP response and returns the response and an error if applicable.
+//
+// A 202 Accepted with an empty body returns an *AcceptedError. Any other status outside of the successful
+// range returns an *ErrorResponse.
+func checkResponse(resp *resty.Response) (*simpleresty.Response, error) {
+	path, _ := url.QueryUnescape(resp.Request.URL)
+	r := &simpleresty.Response{Status: resp.Status(), StatusCode: resp.StatusCode(),
+		Body: string(resp.Body()), Resp: resp, RequestURL: path,
+		RequestMethod: resp.Request.Method, Request: resp.Request}
+
+	// Convert the request body to a string.
+	reqBody, marshallErr := json.Marshal(resp.Request.Body)
+	if marshallErr != nil {
+		return nil, marshallErr
+	}
+	r.RequestBody = string(reqBody)
+
+	// If response is any of the below, return early.
+	switch r.StatusCode {
+	case 202:
+		if len(resp.Body()) == 0 {
+			return r, &AcceptedError{Raw: resp.Body()}
+		}
+		return r, nil
+	case 200, 201, 204, 304:
+		return r, nil
+	}
+
+	// Otherwise, return the response along with the error.
+	errResp := &ErrorResponse{Response: r, StatusCode: r.StatusCode, Method: r.RequestMethod, URL: r.RequestURL}
+	if len(resp.Body()) > 0 {
+		// The body is not guaranteed to be a Bitbucket error object (e.g. an HTML error page from a proxy),
+		// so a decoding failure is not reported.
+		_ = json.Unmarshal(resp.Body(), errResp)
+	}
+
+	return r, errResp
+}
+
+// hasStatus reports whether err is an *ErrorResponse with the given status code.
+func hasStatus(err error, code int) bool {
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) {
+		return errResp.StatusCode == code
+	}
+	return false
+}
+
+// IsNotFound reports whether err is an *ErrorResponse with a 404 status code.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is an *ErrorResponse with a 401 status code.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is an *ErrorResponse with a 403 status code.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsConflict reports whether err is an *ErrorResponse with a 409 status code.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsBadRequest reports whether err is an *ErrorResponse with a 400 status code.
+func IsBadRequest(err error) bool {
+	return hasStatus(err, http.StatusBadRequest)
+}
+
+// IsRateLimited reports whether err is an *ErrorResponse with a 429 status code.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsAccepted reports whether err is an *AcceptedError.
+func IsAccepted(err error) bool {
+	var acceptedErr *AcceptedError
+	return errors.As(err, &acceptedErr)
+}
@@ -0,0 +1,68 @@
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckResponse_ErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"type":"error","error":{"message":"Bad request","detail":"name is invalid","fields":{"name":["This field is required."],"scm":"Invalid scm"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	name := "repo"
+	_, resp, createErr := client.Repositories.Create(context.Background(), "owner", &RepositoryRequest{Name: &name})
+
+	var errResp *ErrorResponse
+	assert.True(t, errors.As(createErr, &errResp))
+	assert.Equal(t, http.StatusBadRequest, errResp.StatusCode)
+	assert.Equal(t, "POST", errResp.Method)
+	assert.Equal(t, server.URL+"/repositories/owner/repo", errResp.URL)
+	assert.Equal(t, "Bad request", errResp.Message())
+	assert.Equal(t, "name is invalid", errResp.GetDetail().Detail)
+	assert.Equal(t, []string{"This field is required."}, errResp.Fields()["name"])
+	assert.Equal(t, []string{"Invalid scm"}, errResp.Fields()["scm"])
+	assert.Equal(t, resp, errResp.Response)
+	assert.True(t, IsBadRequest(createErr))
+	assert.False(t, IsNotFound(createErr))
+}
+
+func TestCheckResponse_NotFoundWithoutBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	_, _, getErr := client.Repositories.Get(context.Background(), "owner", "repo")
+	assert.True(t, IsNotFound(getErr))
+	assert.False(t, IsForbidden(getErr))
+	assert.Nil(t, getErr.(*ErrorResponse).Detail)
+}
+
+func TestCheckResponse_Accepted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	_, resp, getErr := client.Diff.Get(context.Background(), "owner", "repo", "a..b")
+	assert.True(t, IsAccepted(getErr))
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+}
@@ -0,0 +1,140 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// FieldsOf returns the value of PartialRespOpts.Fields that selects paths from a response decoded into T.
+// It panics if a path does not exist in T, so it is meant for paths known at compile time:
+//
+//	opts := &bitbucket.PartialRespOpts{Fields: bitbucket.FieldsOf[bitbucket.PullRequests]("values.id", "values.author.uuid")}
+//
+// See ValidateFields for the path syntax.
+func FieldsOf[T any](paths ...string) string {
+	fields, err := ValidateFields[T](paths...)
+	if err != nil {
+		panic(err)
+	}
+
+	return fields
+}
+
+// ValidateFields checks that paths exist in T and returns them as the value of PartialRespOpts.Fields.
+//
+// Each path is a dot-separated list of the json names of nested fields, such as "values.reviewers.username"
+// for PullRequests, and may itself be a comma-separated list of paths. A path prefixed with "+" adds the field
+// to the default response and one prefixed with "-" removes it. "*" selects every field of an object and
+// may only be the last element of a path.
+func ValidateFields[T any](paths ...string) (string, error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	var fields []string
+	for _, p := range paths {
+		for _, field := range strings.Split(p, ",") {
+			field = strings.TrimSpace(field)
+			if err := validateFieldPath(t, strings.TrimLeft(field, "+-")); err != nil {
+				return "", fmt.Errorf("bitbucket: invalid field %q for %s: %w", field, t, err)
+			}
+
+			fields = append(fields, field)
+		}
+	}
+
+	return strings.Join(fields, ","), nil
+}
+
+// validateFieldPath checks that the dot-separated path exists in t.
+func validateFieldPath(t reflect.Type, path string) error {
+	if path == "" {
+		return fmt.Errorf("empty path")
+	}
+
+	elems := strings.Split(path, ".")
+	for i, elem := range elems {
+		t = fieldContainer(t)
+
+		switch {
+		case t == nil:
+			// Any field is allowed below maps and interfaces.
+			return nil
+		case elem == "":
+			return fmt.Errorf("empty path element")
+		case t.Kind() != reflect.Struct || reflect.PtrTo(t).Implements(jsonUnmarshalerType):
+			// Types that decode themselves, such as time.Time, have no fields of their own.
+			return fmt.Errorf("%s has no fields", strings.Join(elems[:i], "."))
+		case elem == "*":
+			if i != len(elems)-1 {
+				return fmt.Errorf("* must be the last path element")
+			}
+			return nil
+		}
+
+		field, ok := jsonField(t, elem)
+		if !ok {
+			return fmt.Errorf("unknown field %q", strings.Join(elems[:i+1], "."))
+		}
+		t = field
+	}
+
+	return nil
+}
+
+// fieldContainer returns the type whose fields the next path element refers to: pointers and slices are
+// unwrapped, and nil is returned for maps and interfaces, whose fields are not known.
+func fieldContainer(t reflect.Type) reflect.Type {
+	for {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array:
+			t = t.Elem()
+		case reflect.Map, reflect.Interface:
+			return nil
+		default:
+			return t
+		}
+	}
+}
+
+// jsonField returns the type of the field of struct t that is decoded from the json key name,
+// including the fields of embedded structs.
+func jsonField(t reflect.Type, name string) (reflect.Type, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		key, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && key == "" {
+			embedded := f.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if ft, ok := jsonField(embedded, name); ok {
+					return ft, true
+				}
+			}
+			continue
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		if key == "" {
+			key = f.Name
+		}
+
+		if key == name {
+			return f.Type, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,59 @@
+package bitbucket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFields(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths []string
+		want  string
+	}{
+		{"nested", []string{"values.id", "values.author.uuid"}, "values.id,values.author.uuid"},
+		{"slice of structs", []string{"values.reviewers.username"}, "values.reviewers.username"},
+		{"embedded pagination", []string{"next", "pagelen"}, "next,pagelen"},
+		{"modifiers", []string{"-values.links", "+values.reviewers.display_name"}, "-values.links,+values.reviewers.display_name"},
+		{"wildcard", []string{"values.source.*", "*"}, "values.source.*,*"},
+		{"comma-separated", []string{"values.id, values.title"}, "values.id,values.title"},
+		{"time leaf", []string{"values.created_on"}, "values.created_on"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := ValidateFields[PullRequests](tt.paths...)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, fields)
+		})
+	}
+}
+
+func TestValidateFields_Errors(t *testing.T) {
+	for _, path := range []string{
+		"values.idd",
+		"values.author.uuid.x",
+		"values.created_on.year",
+		"values.*.id",
+		"values..id",
+		"",
+		"-",
+	} {
+		t.Run(path, func(t *testing.T) {
+			_, err := ValidateFields[PullRequests](path)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestValidateFields_Interface(t *testing.T) {
+	fields, err := ValidateFields[ReportData]("value.anything")
+	assert.Nil(t, err)
+	assert.Equal(t, "value.anything", fields)
+}
+
+func TestFieldsOf(t *testing.T) {
+	assert.Equal(t, "values.id,values.author.uuid", FieldsOf[PullRequests]("values.id", "values.author.uuid"))
+	assert.Panics(t, func() { FieldsOf[PullRequests]("values.typo") })
+}
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -36,7 +37,7 @@ type FileHistoryListOpts struct {
 // Commits are returned in reverse chronological order.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/filehistory/%7Bnode%7D/%7Bpath%7D#get
-func (fh *FileHistoryService) Get(owner, repoSlug, nodeRev, path string, opts ...interface{}) (*FileHistory, *simpleresty.Response, error) {
+func (fh *FileHistoryService) Get(ctx context.Context, owner, repoSlug, nodeRev, path string, opts ...interface{}) (*FileHistory, *simpleresty.Response, error) {
 	result := new(FileHistory)
 	urlStr, urlStrErr := fh.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/filehistory/%s/%s", owner, repoSlug, nodeRev, path), opts...)
@@ -44,7 +45,7 @@ func (fh *FileHistoryService) Get(owner, repoSlug, nodeRev, path string, opts ..
 		return nil, nil, urlStrErr
 	}
 
-	response, err := fh.client.http.Get(urlStr, result, nil)
+	response, err := fh.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -29,7 +30,7 @@ type ForkRequest struct {
 // List returns a paginated list of all the forks of the specified repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/forks#get
-func (f *ForksService) List(owner, repoSlug string, opts ...interface{}) (*Repositories, *simpleresty.Response, error) {
+func (f *ForksService) List(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Repositories, *simpleresty.Response, error) {
 	result := new(Repositories)
 	urlStr, urlStrErr := f.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/forks", owner, repoSlug), opts...)
@@ -37,7 +38,7 @@ func (f *ForksService) List(owner, repoSlug string, opts ...interface{}) (*Repos
 		return nil, nil, urlStrErr
 	}
 
-	response, err := f.client.http.Get(urlStr, result, nil)
+	response, err := f.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -49,10 +50,10 @@ func (f *ForksService) List(owner, repoSlug string, opts ...interface{}) (*Repos
 // The 'owner' & 'repoSlug' parameters represent the repository you want to fork into your account.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/forks#post
-func (f *ForksService) Create(owner, repoSlug string, fo *ForkRequest) (*Repository, *simpleresty.Response, error) {
+func (f *ForksService) Create(ctx context.Context, owner, repoSlug string, fo *ForkRequest) (*Repository, *simpleresty.Response, error) {
 	result := new(Repository)
 	urlStr := f.client.http.RequestURL("/repositories/%s/%s/forks", owner, repoSlug)
-	response, err := f.client.http.Post(urlStr, result, fo)
+	response, err := f.client.post(ctx, urlStr, result, fo)
 
 	return result, response, err
 }
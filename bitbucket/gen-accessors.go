@@ -44,6 +44,7 @@ var (
 		"ErrorResponse.GetResponse":       true,
 		"RateLimitError.GetResponse":      true,
 		"AbuseRateLimitError.GetResponse": true,
+		"Diff.GetStatus":                  true,
 	}
 	// blacklistStruct lists structs to skip.
 	blacklistStruct = map[string]bool{
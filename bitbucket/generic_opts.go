@@ -23,6 +23,8 @@ type ListOpts struct {
 // FilterSortOpts represents the querying and sorting mechanism available
 // to certain Bitbucket API resources that return multiple results in a response.
 //
+// The bql package builds both fields with correct quoting and escaping.
+//
 // Bitbucket API Docs: https://developer.atlassian.com/bitbucket/api/2/reference/meta/filtering#query-sort
 type FilterSortOpts struct {
 	// Query is the raw non-URL encoded query string.
@@ -42,5 +44,6 @@ type FilterSortOpts struct {
 // Bitbucket API Docs: https://developer.atlassian.com/bitbucket/api/2/reference/meta/partial-response
 type PartialRespOpts struct {
 	// The fields parameter can contain a list of multiple comma-separated field names (e.g. fields=owner.username,uuid,links.self.href).
+	// Use FieldsOf to check the field names against the type the response is decoded into.
 	Fields string `url:"fields,omitempty"`
 }
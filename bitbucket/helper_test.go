@@ -1,7 +1,10 @@
 package bitbucket
 
 import (
+	"encoding/json"
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -20,3 +23,25 @@ func TestParseForResourceId_Invalid(t *testing.T) {
 
 	assert.Equal(t, &expected, result)
 }
+
+// capturedRequest holds the method, path, query and decoded JSON body of the last request received by a capturing server.
+type capturedRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Body   map[string]interface{}
+}
+
+// newCapturingServer returns a server that captures every request and replies with status and the JSON response.
+func newCapturingServer(captured *capturedRequest, status int, response string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*captured = capturedRequest{Method: r.Method, Path: r.URL.Path, Query: r.URL.Query().Encode()}
+		json.NewDecoder(r.Body).Decode(&captured.Body)
+
+		if response != "" {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(response))
+	}))
+}
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -41,7 +42,7 @@ type HookEventTypesLinks struct {
 // List returns the webhook resource or subject types on which webhooks can be registered.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/hook_events#get
-func (h *HookEventsService) List(opts ...interface{}) (*HookEventTypes, *simpleresty.Response, error) {
+func (h *HookEventsService) List(ctx context.Context, opts ...interface{}) (*HookEventTypes, *simpleresty.Response, error) {
 	result := new(HookEventTypes)
 	urlStr, urlStrErr := h.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/hook_events"), opts...)
@@ -49,7 +50,7 @@ func (h *HookEventsService) List(opts ...interface{}) (*HookEventTypes, *simpler
 		return nil, nil, urlStrErr
 	}
 
-	response, err := h.client.http.Get(urlStr, result, nil)
+	response, err := h.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -57,7 +58,7 @@ func (h *HookEventsService) List(opts ...interface{}) (*HookEventTypes, *simpler
 // Get returns a paginated list of all valid webhook events for the specified entity.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/hook_events/%7Bsubject_type%7D#get
-func (h *HookEventsService) Get(subjectType string, opts ...interface{}) (*HookEvents, *simpleresty.Response, error) {
+func (h *HookEventsService) Get(ctx context.Context, subjectType string, opts ...interface{}) (*HookEvents, *simpleresty.Response, error) {
 	result := new(HookEvents)
 	urlStr, urlStrErr := h.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/hook_events/%s", subjectType), opts...)
@@ -65,7 +66,7 @@ func (h *HookEventsService) Get(subjectType string, opts ...interface{}) (*HookE
 		return nil, nil, urlStrErr
 	}
 
-	response, err := h.client.http.Get(urlStr, result, nil)
+	response, err := h.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
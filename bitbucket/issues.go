@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"time"
@@ -86,7 +87,7 @@ type IssueRequestAssigneeOpts struct {
 // List returns all issues for a given repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues#get
-func (i *IssuesService) List(owner, repoSlug string, opts ...interface{}) (*Issues, *simpleresty.Response, error) {
+func (i *IssuesService) List(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Issues, *simpleresty.Response, error) {
 	result := new(Issues)
 	urlStr, urlStrErr := i.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/issues", owner, repoSlug), opts...)
@@ -94,7 +95,7 @@ func (i *IssuesService) List(owner, repoSlug string, opts ...interface{}) (*Issu
 		return nil, nil, urlStrErr
 	}
 
-	response, err := i.client.http.Get(urlStr, result, nil)
+	response, err := i.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -102,7 +103,7 @@ func (i *IssuesService) List(owner, repoSlug string, opts ...interface{}) (*Issu
 // Get a single issue.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D#get
-func (i *IssuesService) Get(owner, repoSlug string, issueID int64, opts ...interface{}) (*Issue, *simpleresty.Response, error) {
+func (i *IssuesService) Get(ctx context.Context, owner, repoSlug string, issueID int64, opts ...interface{}) (*Issue, *simpleresty.Response, error) {
 	result := new(Issue)
 	urlStr, urlStrErr := i.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/issues/%v", owner, repoSlug, issueID), opts...)
@@ -110,7 +111,7 @@ func (i *IssuesService) Get(owner, repoSlug string, issueID int64, opts ...inter
 		return nil, nil, urlStrErr
 	}
 
-	response, err := i.client.http.Get(urlStr, result, nil)
+	response, err := i.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -118,10 +119,10 @@ func (i *IssuesService) Get(owner, repoSlug string, issueID int64, opts ...inter
 // Create a new issue.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues#post
-func (i *IssuesService) Create(owner, repoSlug string, io *IssueRequest) (*Issue, *simpleresty.Response, error) {
+func (i *IssuesService) Create(ctx context.Context, owner, repoSlug string, io *IssueRequest) (*Issue, *simpleresty.Response, error) {
 	result := new(Issue)
 	urlStr := i.client.http.RequestURL("/repositories/%s/%s/issues", owner, repoSlug)
-	response, err := i.client.http.Post(urlStr, result, io)
+	response, err := i.client.post(ctx, urlStr, result, io)
 
 	return result, response, err
 }
@@ -129,10 +130,10 @@ func (i *IssuesService) Create(owner, repoSlug string, io *IssueRequest) (*Issue
 // Update an issue.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D#put
-func (i *IssuesService) Update(owner, repoSlug string, issueID int64, io *IssueRequest) (*Issue, *simpleresty.Response, error) {
+func (i *IssuesService) Update(ctx context.Context, owner, repoSlug string, issueID int64, io *IssueRequest) (*Issue, *simpleresty.Response, error) {
 	result := new(Issue)
 	urlStr := i.client.http.RequestURL("/repositories/%s/%s/issues/%v", owner, repoSlug, issueID)
-	response, err := i.client.http.Put(urlStr, result, io)
+	response, err := i.client.put(ctx, urlStr, result, io)
 
 	return result, response, err
 }
@@ -140,9 +141,9 @@ func (i *IssuesService) Update(owner, repoSlug string, issueID int64, io *IssueR
 // Delete the specified issue. This requires write access to the repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D#delete
-func (i *IssuesService) Delete(owner, repoSlug string, issueID int64) (*simpleresty.Response, error) {
+func (i *IssuesService) Delete(ctx context.Context, owner, repoSlug string, issueID int64) (*simpleresty.Response, error) {
 	urlStr := i.client.http.RequestURL("/repositories/%s/%s/issues/%v", owner, repoSlug, issueID)
-	response, err := i.client.http.Delete(urlStr, nil, nil)
+	response, err := i.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"reflect"
@@ -48,7 +49,7 @@ type IssueChangeRequest struct {
 // Changes are returned in chronological order with the oldest change first.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D/changes#get
-func (i *IssuesService) ListChanges(owner, repoSlug string, id int64, opts ...interface{}) (*IssueChanges, *simpleresty.Response, error) {
+func (i *IssuesService) ListChanges(ctx context.Context, owner, repoSlug string, id int64, opts ...interface{}) (*IssueChanges, *simpleresty.Response, error) {
 	result := new(IssueChanges)
 	urlStr, urlStrErr := i.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/issues/%v/changes", owner, repoSlug, id), opts...)
@@ -56,7 +57,7 @@ func (i *IssuesService) ListChanges(owner, repoSlug string, id int64, opts ...in
 		return nil, nil, urlStrErr
 	}
 
-	response, err := i.client.http.Get(urlStr, result, nil)
+	response, err := i.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -64,7 +65,7 @@ func (i *IssuesService) ListChanges(owner, repoSlug string, id int64, opts ...in
 // GetChange returns the specified issue change object.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D/changes/%7Bchange_id%7D#get
-func (i *IssuesService) GetChange(owner, repoSlug string, id, changeID int64, opts ...interface{}) (*IssueChange, *simpleresty.Response, error) {
+func (i *IssuesService) GetChange(ctx context.Context, owner, repoSlug string, id, changeID int64, opts ...interface{}) (*IssueChange, *simpleresty.Response, error) {
 	result := new(IssueChange)
 	urlStr, urlStrErr := i.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/issues/%v/changes/%v", owner, repoSlug, id, changeID), opts...)
@@ -72,7 +73,7 @@ func (i *IssuesService) GetChange(owner, repoSlug string, id, changeID int64, op
 		return nil, nil, urlStrErr
 	}
 
-	response, err := i.client.http.Get(urlStr, result, nil)
+	response, err := i.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -80,10 +81,10 @@ func (i *IssuesService) GetChange(owner, repoSlug string, id, changeID int64, op
 // CreateChange makes a change to the specified issue.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D/changes#post
-func (i *IssuesService) CreateChange(owner, repoSlug string, id int64, io *IssueChangeRequest) (*IssueChange, *simpleresty.Response, error) {
+func (i *IssuesService) CreateChange(ctx context.Context, owner, repoSlug string, id int64, io *IssueChangeRequest) (*IssueChange, *simpleresty.Response, error) {
 	result := new(IssueChange)
 	urlStr := i.client.http.RequestURL("/repositories/%s/%s/issues/%v/changes", owner, repoSlug, id)
-	response, err := i.client.http.Post(urlStr, result, io.buildChangeRequestBody())
+	response, err := i.client.post(ctx, urlStr, result, io.buildChangeRequestBody())
 
 	return result, response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"time"
@@ -10,7 +11,7 @@ import (
 type IssueComments struct {
 	PaginationInfo
 
-	Values []*IssueChange `json:"values,omitempty"`
+	Values []*IssueComment `json:"values,omitempty"`
 }
 
 // IssueComment represents a comment on an issue.
@@ -39,7 +40,7 @@ type IssueCommentRequest struct {
 // ListComments returns a paginated list of all comments that were made on the specified issue.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D/comments#get
-func (i *IssuesService) ListComments(owner, repoSlug string, id int64, opts ...interface{}) (*IssueComments, *simpleresty.Response, error) {
+func (i *IssuesService) ListComments(ctx context.Context, owner, repoSlug string, id int64, opts ...interface{}) (*IssueComments, *simpleresty.Response, error) {
 	result := new(IssueComments)
 	urlStr, urlStrErr := i.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/issues/%v/comments", owner, repoSlug, id), opts...)
@@ -47,7 +48,7 @@ func (i *IssuesService) ListComments(owner, repoSlug string, id int64, opts ...i
 		return nil, nil, urlStrErr
 	}
 
-	response, err := i.client.http.Get(urlStr, result, nil)
+	response, err := i.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -55,10 +56,10 @@ func (i *IssuesService) ListComments(owner, repoSlug string, id int64, opts ...i
 // CreateComment creates a new issue comment.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D/comments#post
-func (i *IssuesService) CreateComment(owner, repoSlug string, id int64, io *IssueCommentRequest) (*IssueComment, *simpleresty.Response, error) {
+func (i *IssuesService) CreateComment(ctx context.Context, owner, repoSlug string, id int64, io *IssueCommentRequest) (*IssueComment, *simpleresty.Response, error) {
 	result := new(IssueComment)
 	urlStr := i.client.http.RequestURL("/repositories/%s/%s/issues/%v/comments", owner, repoSlug, id)
-	response, err := i.client.http.Post(urlStr, result, io)
+	response, err := i.client.post(ctx, urlStr, result, io)
 
 	return result, response, err
 }
@@ -66,7 +67,7 @@ func (i *IssuesService) CreateComment(owner, repoSlug string, id int64, io *Issu
 // GetComment returns the specified issue comment object.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D/comments/%7Bcomment_id%7D#get
-func (i *IssuesService) GetComment(owner, repoSlug string, id, commentID int64, opts ...interface{}) (*IssueComment, *simpleresty.Response, error) {
+func (i *IssuesService) GetComment(ctx context.Context, owner, repoSlug string, id, commentID int64, opts ...interface{}) (*IssueComment, *simpleresty.Response, error) {
 	result := new(IssueComment)
 	urlStr, urlStrErr := i.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/issues/%v/comments/%v", owner, repoSlug, id, commentID), opts...)
@@ -74,7 +75,7 @@ func (i *IssuesService) GetComment(owner, repoSlug string, id, commentID int64,
 		return nil, nil, urlStrErr
 	}
 
-	response, err := i.client.http.Get(urlStr, result, nil)
+	response, err := i.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -82,10 +83,10 @@ func (i *IssuesService) GetComment(owner, repoSlug string, id, commentID int64,
 // UpdateComment updates an existing issue comment.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D/comments/%7Bcomment_id%7D#put
-func (i *IssuesService) UpdateComment(owner, repoSlug string, id, commentID int64, io *IssueCommentRequest) (*IssueComment, *simpleresty.Response, error) {
+func (i *IssuesService) UpdateComment(ctx context.Context, owner, repoSlug string, id, commentID int64, io *IssueCommentRequest) (*IssueComment, *simpleresty.Response, error) {
 	result := new(IssueComment)
 	urlStr := i.client.http.RequestURL("/repositories/%s/%s/issues/%v/comments/%v", owner, repoSlug, id, commentID)
-	response, err := i.client.http.Put(urlStr, result, io)
+	response, err := i.client.put(ctx, urlStr, result, io)
 
 	return result, response, err
 }
@@ -93,9 +94,9 @@ func (i *IssuesService) UpdateComment(owner, repoSlug string, id, commentID int6
 // DeleteComment deletes an existing issue comment.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D/comments/%7Bcomment_id%7D#delete
-func (i *IssuesService) DeleteComment(owner, repoSlug string, id, commentID int64) (*simpleresty.Response, error) {
+func (i *IssuesService) DeleteComment(ctx context.Context, owner, repoSlug string, id, commentID int64) (*simpleresty.Response, error) {
 	urlStr := i.client.http.RequestURL("/repositories/%s/%s/issues/%v/comments/%v", owner, repoSlug, id, commentID)
-	response, err := i.client.http.Delete(urlStr, nil, nil)
+	response, err := i.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
@@ -1,17 +1,23 @@
 package bitbucket
 
-import "github.com/davidji99/simpleresty"
+import (
+	"context"
+	"github.com/davidji99/simpleresty"
+)
 
 // HasCurrentUserVoted check whether the authenticated user has voted for this issue.
 //
 // A 204 status code indicates that the user has voted, while a 404 implies they haven't.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D/vote#get
-func (i *IssuesService) HasCurrentUserVoted(owner, repoSlug string, id int64) (bool, *simpleresty.Response, error) {
+func (i *IssuesService) HasCurrentUserVoted(ctx context.Context, owner, repoSlug string, id int64) (bool, *simpleresty.Response, error) {
 	urlStr := i.client.http.RequestURL("/repositories/%s/%s/issues/%v/vote", owner, repoSlug, id)
-	response, err := i.client.http.Get(urlStr, nil, nil)
+	response, err := i.client.get(ctx, urlStr, nil, nil)
+	if IsNotFound(err) {
+		return false, response, nil
+	}
 	if err != nil {
-		return false, nil, err
+		return false, response, err
 	}
 
 	hasVoted := false
@@ -27,9 +33,9 @@ func (i *IssuesService) HasCurrentUserVoted(owner, repoSlug string, id int64) (b
 // The 204 status code indicates that the operation was successful.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D/vote#put
-func (i *IssuesService) Vote(owner, repoSlug string, id int64) (*simpleresty.Response, error) {
+func (i *IssuesService) Vote(ctx context.Context, owner, repoSlug string, id int64) (*simpleresty.Response, error) {
 	urlStr := i.client.http.RequestURL("/repositories/%s/%s/issues/%v/vote", owner, repoSlug, id)
-	response, err := i.client.http.Put(urlStr, nil, nil)
+	response, err := i.client.put(ctx, urlStr, nil, nil)
 
 	return response, err
 }
@@ -37,9 +43,9 @@ func (i *IssuesService) Vote(owner, repoSlug string, id int64) (*simpleresty.Res
 // RemoveVote retract your vote.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D/vote#delete
-func (i *IssuesService) RemoveVote(owner, repoSlug string, id int64) (*simpleresty.Response, error) {
+func (i *IssuesService) RemoveVote(ctx context.Context, owner, repoSlug string, id int64) (*simpleresty.Response, error) {
 	urlStr := i.client.http.RequestURL("/repositories/%s/%s/issues/%v/vote", owner, repoSlug, id)
-	response, err := i.client.http.Delete(urlStr, nil, nil)
+	response, err := i.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
@@ -1,30 +1,39 @@
 package bitbucket
 
-import "github.com/davidji99/simpleresty"
+import (
+	"context"
+	"github.com/davidji99/simpleresty"
+)
 
 // IsAuthUserWatching check whether the authenticated user is watching the specified issue.
 //
-// A 204 status code indicates that the user is watching this issue.
+// A 204 status code indicates that the user is watching this issue, while a 404 implies they aren't.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D/watch#get
-func (i *IssuesService) IsAuthUserWatching(owner, repoSlug string, id int64) (bool, *simpleresty.Response, error) {
+func (i *IssuesService) IsAuthUserWatching(ctx context.Context, owner, repoSlug string, id int64) (bool, *simpleresty.Response, error) {
 	urlStr := i.client.http.RequestURL("/repositories/%s/%s/issues/%v/watch", owner, repoSlug, id)
-	response, err := i.client.http.Get(urlStr, nil, nil)
+	response, err := i.client.get(ctx, urlStr, nil, nil)
+	if IsNotFound(err) {
+		return false, response, nil
+	}
+	if err != nil {
+		return false, response, err
+	}
 
-	hasVoted := false
+	isWatching := false
 	if response.StatusCode == 204 {
-		hasVoted = true
+		isWatching = true
 	}
 
-	return hasVoted, response, err
+	return isWatching, response, nil
 }
 
 // WatchIssue starts watching the specified issue.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D/watch#put
-func (i *IssuesService) WatchIssue(owner, repoSlug string, id int64) (*simpleresty.Response, error) {
+func (i *IssuesService) WatchIssue(ctx context.Context, owner, repoSlug string, id int64) (*simpleresty.Response, error) {
 	urlStr := i.client.http.RequestURL("/repositories/%s/%s/issues/%v/watch", owner, repoSlug, id)
-	response, err := i.client.http.Put(urlStr, nil, nil)
+	response, err := i.client.put(ctx, urlStr, nil, nil)
 
 	return response, err
 }
@@ -32,9 +41,9 @@ func (i *IssuesService) WatchIssue(owner, repoSlug string, id int64) (*simpleres
 // StopWatchingIssue stops watching the specified issue.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/issues/%7Bissue_id%7D/watch#delete
-func (i *IssuesService) StopWatchingIssue(owner, repoSlug string, id int64) (*simpleresty.Response, error) {
+func (i *IssuesService) StopWatchingIssue(ctx context.Context, owner, repoSlug string, id int64) (*simpleresty.Response, error) {
 	urlStr := i.client.http.RequestURL("/repositories/%s/%s/issues/%v/watch", owner, repoSlug, id)
-	response, err := i.client.http.Delete(urlStr, nil, nil)
+	response, err := i.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
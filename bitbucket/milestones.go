@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -43,7 +44,7 @@ type MilestoneRequest struct {
 // List all milestones that have been defined in the issue tracker.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/milestones#get
-func (m *MilestonesService) List(owner, repoSlug string, opts ...interface{}) (*Milestones, *simpleresty.Response, error) {
+func (m *MilestonesService) List(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Milestones, *simpleresty.Response, error) {
 	result := new(Milestones)
 	urlStr, urlStrErr := m.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/milestones", owner, repoSlug), opts...)
@@ -51,7 +52,7 @@ func (m *MilestonesService) List(owner, repoSlug string, opts ...interface{}) (*
 		return nil, nil, urlStrErr
 	}
 
-	response, err := m.client.http.Get(urlStr, result, nil)
+	response, err := m.client.get(ctx, urlStr, result, nil)
 
 	// Parse and store the milestone id
 	for _, milestone := range result.Values {
@@ -65,7 +66,7 @@ func (m *MilestonesService) List(owner, repoSlug string, opts ...interface{}) (*
 // NOTE: The milestone ID is a numerical value, not the component name, that is visible in the links.self.href object.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/milestones/%7Bmilestone_id%7D#get
-func (m *MilestonesService) Get(owner, repoSlug string, milestoneID int64, opts ...interface{}) (*Milestone, *simpleresty.Response, error) {
+func (m *MilestonesService) Get(ctx context.Context, owner, repoSlug string, milestoneID int64, opts ...interface{}) (*Milestone, *simpleresty.Response, error) {
 	result := new(Milestone)
 	urlStr, urlStrErr := m.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/milestones/%v", owner, repoSlug, milestoneID), opts...)
@@ -73,7 +74,7 @@ func (m *MilestonesService) Get(owner, repoSlug string, milestoneID int64, opts
 		return nil, nil, urlStrErr
 	}
 
-	response, err := m.client.http.Get(urlStr, result, nil)
+	response, err := m.client.get(ctx, urlStr, result, nil)
 
 	// Parse and store the milestone id
 	result.ID = parseForResourceID(milestoneSelfURL, *result.Links.Self.HRef)
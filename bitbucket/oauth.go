@@ -0,0 +1,36 @@
+package bitbucket
+
+import (
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// notifyTokenSource wraps a token source and passes every new token to notify, so callers can persist refreshed tokens.
+type notifyTokenSource struct {
+	src    oauth2.TokenSource
+	notify func(*oauth2.Token) error
+
+	mu   sync.Mutex
+	last string
+}
+
+// Token returns a token from the wrapped source, calling notify first if it differs from the previous one.
+func (s *notifyTokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, err := s.src.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if token.AccessToken != s.last {
+		if err := s.notify(token); err != nil {
+			return nil, err
+		}
+		s.last = token.AccessToken
+	}
+
+	return token, nil
+}
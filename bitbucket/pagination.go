@@ -0,0 +1,222 @@
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/davidji99/simpleresty"
+)
+
+// ErrNoMorePages is returned by Pager.NextPage when the collection has been exhausted
+// or the pager's item limit has been reached.
+var ErrNoMorePages = errors.New("bitbucket: no more pages")
+
+// Page is implemented by every collection type returned from a List method, such as PullRequests or Refs.
+// T is the type of a single item in the collection.
+type Page[T any] interface {
+	GetNext() string
+	values() []T
+}
+
+// ListFunc fetches the first page of a collection. It is typically a closure around a List method
+// that forwards the given opts, for example:
+//
+//	func(ctx context.Context, opts ...interface{}) (*bitbucket.PullRequests, *simpleresty.Response, error) {
+//		return client.PullRequests.List(ctx, owner, repoSlug, opts...)
+//	}
+type ListFunc[P any] func(ctx context.Context, opts ...interface{}) (P, *simpleresty.Response, error)
+
+// Pager iterates over a paginated collection, either item by item with Next or page by page with NextPage.
+// The two styles should not be mixed on the same Pager.
+//
+// Only the first page is fetched through the ListFunc. Every following page is fetched from the
+// page's Next link, as recommended by the Bitbucket API docs, so the pager never builds page numbers itself.
+//
+// Bitbucket API Docs: https://developer.atlassian.com/bitbucket/api/2/reference/meta/pagination
+type Pager[T any, P Page[T]] struct {
+	client *Client
+	list   ListFunc[P]
+	opts   []interface{}
+
+	// limit caps the total number of items returned. Zero means no limit.
+	limit int
+	count int
+
+	started bool
+	next    string
+
+	items []T
+	item  T
+	resp  *simpleresty.Response
+	err   error
+}
+
+// NewPager returns a Pager over the collection returned by list. Any opts, such as ListOpts or FilterSortOpts,
+// are passed to list for the first page only; Bitbucket carries them over in the Next link.
+//
+// The item type must be given explicitly, for example:
+//
+//	pager := bitbucket.NewPager[*bitbucket.PullRequest](client, listFunc, &bitbucket.ListOpts{Pagelen: 50})
+func NewPager[T any, P Page[T]](client *Client, list ListFunc[P], opts ...interface{}) *Pager[T, P] {
+	return &Pager[T, P]{client: client, list: list, opts: opts}
+}
+
+// Limit caps the total number of items the pager returns. With NextPage, no further page is
+// fetched once the limit is reached, although the last page may hold more items than the limit.
+func (p *Pager[T, P]) Limit(n int) *Pager[T, P] {
+	p.limit = n
+	return p
+}
+
+// More reports whether another page is available.
+func (p *Pager[T, P]) More() bool {
+	if p.limit > 0 && p.count >= p.limit {
+		return false
+	}
+	return !p.started || p.next != ""
+}
+
+// NextPage fetches the next page of the collection. It returns ErrNoMorePages once More reports false.
+func (p *Pager[T, P]) NextPage(ctx context.Context) (P, *simpleresty.Response, error) {
+	var page P
+	if !p.More() {
+		return page, nil, ErrNoMorePages
+	}
+
+	var response *simpleresty.Response
+	var err error
+	if !p.started {
+		page, response, err = p.list(ctx, p.opts...)
+	} else {
+		page = reflect.New(reflect.TypeOf(page).Elem()).Interface().(P)
+		response, err = p.client.get(ctx, p.next, page, nil)
+	}
+	p.resp = response
+	if err != nil {
+		return page, response, err
+	}
+
+	p.started = true
+	p.next = page.GetNext()
+	p.count += len(page.values())
+
+	return page, response, nil
+}
+
+// Next advances the pager to the next item, fetching a new page when needed.
+// It returns false when the collection is exhausted, the limit is reached, the context ends or an error occurs.
+// Breaking out of the loop early stops the pager without fetching further pages.
+//
+//	for pager.Next(ctx) {
+//		pr := pager.Item()
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+func (p *Pager[T, P]) Next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+
+	for len(p.items) == 0 {
+		if !p.More() {
+			return false
+		}
+
+		// Count items as they are handed out rather than per page, so the limit is exact.
+		count := p.count
+		page, _, err := p.NextPage(ctx)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.count = count
+		p.items = page.values()
+	}
+
+	if p.limit > 0 && p.count >= p.limit {
+		p.items = nil
+		return false
+	}
+
+	p.item = p.items[0]
+	p.items = p.items[1:]
+	p.count++
+
+	return true
+}
+
+// Item returns the current item after a successful call to Next.
+func (p *Pager[T, P]) Item() T {
+	return p.item
+}
+
+// Err returns the first error encountered by Next.
+func (p *Pager[T, P]) Err() error {
+	return p.err
+}
+
+// Response returns the response of the most recently fetched page.
+func (p *Pager[T, P]) Response() *simpleresty.Response {
+	return p.resp
+}
+
+// All fetches every remaining item, up to the limit if one is set.
+func (p *Pager[T, P]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for p.Next(ctx) {
+		all = append(all, p.Item())
+	}
+
+	return all, p.Err()
+}
+
+func (p *Artifacts) values() []*Artifact                                     { return p.Values }
+func (p *BranchRestrictions) values() []*BranchRestriction                   { return p.Values }
+func (p *CommitComments) values() []*CommitComment                           { return p.Values }
+func (p *CommitStatuses) values() []*CommitStatus                            { return p.Values }
+func (p *Commits) values() []*Commit                                         { return p.Values }
+func (p *Components) values() []*Component                                   { return p.Values }
+func (p *DeployKeys) values() []*DeployKey                                   { return p.Values }
+func (p *Deployments) values() []*Deployment                                 { return p.Values }
+func (p *Diffs) values() []*Diff                                             { return p.Values }
+func (p *Environments) values() []*Environment                               { return p.Values }
+func (p *FileHistory) values() []*SRCMetadata                                { return p.Values }
+func (p *HookEvents) values() []*HookEvent                                   { return p.Values }
+func (p *IssueChanges) values() []*IssueChange                               { return p.Values }
+func (p *IssueComments) values() []*IssueComment                             { return p.Values }
+func (p *Issues) values() []*Issue                                           { return p.Values }
+func (p *Milestones) values() []*Milestone                                   { return p.Values }
+func (p *PRActivities) values() []*PRActivity                                { return p.Values }
+func (p *PRComments) values() []*PRComment                                   { return p.Values }
+func (p *PRTasks) values() []*PRTask                                         { return p.Values }
+func (p *PipelineKnownHosts) values() []*PipelineKnownHost                   { return p.Values }
+func (p *PipelineScheduleExecutions) values() []*PipelineScheduleExecution   { return p.Values }
+func (p *PipelineSchedules) values() []*PipelineSchedule                     { return p.Values }
+func (p *PipelineSteps) values() []*PipelineStep                             { return p.Values }
+func (p *PipelineTestCaseReasons) values() []*PipelineTestCaseReason         { return p.Values }
+func (p *PipelineTestCases) values() []*PipelineTestCase                     { return p.Values }
+func (p *PipelineVariables) values() []*PipelineVariable                     { return p.Values }
+func (p *Pipelines) values() []*Pipeline                                     { return p.Values }
+func (p *PullRequests) values() []*PullRequest                               { return p.Values }
+func (p *Refs) values() []*Ref                                               { return p.Values }
+func (p *ReportAnnotations) values() []*ReportAnnotation                     { return p.Values }
+func (p *Reports) values() []*Report                                         { return p.Values }
+func (p *Repositories) values() []*Repository                                { return p.Values }
+func (p *RepositoryHooks) values() []*RepositoryHook                         { return p.Values }
+func (p *SearchCodeResults) values() []*SearchCodeResult                     { return p.Values }
+func (p *TeamPermissions) values() []*TeamPermission                         { return p.Values }
+func (p *TeamProjects) values() []*TeamProject                               { return p.Values }
+func (p *TeamRepoPermissions) values() []*TeamRepoPermission                 { return p.Values }
+func (p *Teams) values() []*Team                                             { return p.Values }
+func (p *UserEmails) values() []*UserEmail                                   { return p.Values }
+func (p *UserHooks) values() []*UserHook                                     { return p.Values }
+func (p *UserRepositoriesPermissions) values() []*UserRepositoriesPermission { return p.Values }
+func (p *UserTeamsPermissions) values() []*UserTeamsPermission               { return p.Values }
+func (p *Users) values() []*User                                             { return p.Values }
+func (p *UsersSSHKeys) values() []*UsersSSHKey                               { return p.Values }
+func (p *Versions) values() []*Version                                       { return p.Values }
+func (p *WorkspaceHooks) values() []*WorkspaceHook                           { return p.Values }
+func (p *WorkspaceMemberships) values() []*WorkspaceMembership               { return p.Values }
+func (p *Workspaces) values() []*Workspace                                   { return p.Values }
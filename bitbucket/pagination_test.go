@@ -0,0 +1,114 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/davidji99/simpleresty"
+	"github.com/stretchr/testify/assert"
+)
+
+// newPagedServer serves three pages of two pull requests each, linking them through "next".
+func newPagedServer(requests *[]string) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.RequestURI())
+
+		page := 1
+		if p := r.URL.Query().Get("cursor"); p != "" {
+			fmt.Sscanf(p, "%d", &page)
+		}
+
+		next := ""
+		if page < 3 {
+			next = fmt.Sprintf(`,"next":"%s/repositories/owner/repo/pullrequests?cursor=%d"`, server.URL, page+1)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"pagelen":2,"values":[{"id":%d},{"id":%d}]%s}`, page*2-1, page*2, next)
+	}))
+
+	return server
+}
+
+func newPRPager(client *Client) *Pager[*PullRequest, *PullRequests] {
+	return NewPager[*PullRequest](client, func(ctx context.Context, opts ...interface{}) (*PullRequests, *simpleresty.Response, error) {
+		return client.PullRequests.List(ctx, "owner", "repo", opts...)
+	}, &ListOpts{Pagelen: 2})
+}
+
+func TestPager_All(t *testing.T) {
+	var requests []string
+	server := newPagedServer(&requests)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	prs, err := newPRPager(client).All(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, prs, 6)
+	assert.Equal(t, int64(6), prs[5].GetID())
+	assert.Equal(t, []string{
+		"/repositories/owner/repo/pullrequests?pagelen=2",
+		"/repositories/owner/repo/pullrequests?cursor=2",
+		"/repositories/owner/repo/pullrequests?cursor=3",
+	}, requests)
+}
+
+func TestPager_Limit(t *testing.T) {
+	var requests []string
+	server := newPagedServer(&requests)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	prs, err := newPRPager(client).Limit(3).All(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, prs, 3)
+	assert.Len(t, requests, 2)
+}
+
+func TestPager_NextPage(t *testing.T) {
+	var requests []string
+	server := newPagedServer(&requests)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	pager := newPRPager(client)
+	pages := 0
+	for pager.More() {
+		page, _, err := pager.NextPage(context.Background())
+		assert.Nil(t, err)
+		assert.Len(t, page.Values, 2)
+		pages++
+	}
+	assert.Equal(t, 3, pages)
+
+	_, _, err = pager.NextPage(context.Background())
+	assert.Equal(t, ErrNoMorePages, err)
+}
+
+func TestPager_StopEarly(t *testing.T) {
+	var requests []string
+	server := newPagedServer(&requests)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	pager := newPRPager(client)
+	for pager.Next(context.Background()) {
+		if pager.Item().GetID() == 2 {
+			break
+		}
+	}
+	assert.Nil(t, pager.Err())
+	assert.Len(t, requests, 1)
+}
@@ -2,6 +2,7 @@ package bitbucket
 
 import (
 	"bytes"
+	"context"
 	"github.com/davidji99/simpleresty"
 )
 
@@ -16,19 +17,18 @@ type PatchService service
 // represents the source and the second commit the destination).
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/patch/%7Bspec%7D#get
-func (p *PatchService) GetRaw(owner, repoSlug, spec string) (*bytes.Buffer, *simpleresty.Response, error) {
+func (p *PatchService) GetRaw(ctx context.Context, owner, repoSlug, spec string) (*bytes.Buffer, *simpleresty.Response, error) {
 	urlStr := p.client.http.RequestURL("/repositories/%s/%s/patch/%s", owner, repoSlug, spec)
 
-	var buff bytes.Buffer
-	req := p.client.http.NewRequest()
+	req := p.client.newRequest(ctx)
 	req.Method = simpleresty.GetMethod
 	req.URL = urlStr
-	req.Result = &buff
+	req.SetHeader("Accept", "text/plain")
 
-	response, reqErr := p.client.http.Dispatch(req)
+	response, reqErr := p.client.dispatch(req)
 	if reqErr != nil {
-		return nil, nil, reqErr
+		return nil, response, reqErr
 	}
 
-	return &buff, response, nil
+	return bytes.NewBuffer(response.Resp.Body()), response, nil
 }
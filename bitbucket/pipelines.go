@@ -0,0 +1,204 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"time"
+)
+
+// PipelinesService handles communication with the pipelines related methods
+// of the Bitbucket API.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/
+type PipelinesService service
+
+const (
+	// PipelineRefTargetType targets the head of a branch or tag.
+	PipelineRefTargetType = "pipeline_ref_target"
+
+	// PipelineCommitTargetType targets a specific commit.
+	PipelineCommitTargetType = "pipeline_commit_target"
+
+	// PipelinePullRequestTargetType targets a pull request.
+	PipelinePullRequestTargetType = "pipeline_pullrequest_target"
+)
+
+const (
+	// PipelineSelectorBranches selects a pipeline defined under "branches" in bitbucket-pipelines.yml.
+	PipelineSelectorBranches = "branches"
+
+	// PipelineSelectorTags selects a pipeline defined under "tags" in bitbucket-pipelines.yml.
+	PipelineSelectorTags = "tags"
+
+	// PipelineSelectorCustom selects a pipeline defined under "custom" in bitbucket-pipelines.yml.
+	PipelineSelectorCustom = "custom"
+
+	// PipelineSelectorPullRequests selects a pipeline defined under "pull-requests" in bitbucket-pipelines.yml.
+	PipelineSelectorPullRequests = "pull-requests"
+
+	// PipelineSelectorDefault selects the default pipeline in bitbucket-pipelines.yml.
+	PipelineSelectorDefault = "default"
+)
+
+// Pipelines represents a collection of pipelines.
+type Pipelines struct {
+	PaginationInfo
+
+	Values []*Pipeline `json:"values,omitempty"`
+}
+
+// Pipeline represents a Bitbucket pipeline run.
+type Pipeline struct {
+	Type              *string             `json:"type,omitempty"`
+	UUID              *string             `json:"uuid,omitempty"`
+	BuildNumber       *int64              `json:"build_number,omitempty"`
+	Creator           *User               `json:"creator,omitempty"`
+	Repository        *Repository         `json:"repository,omitempty"`
+	Target            *PipelineTarget     `json:"target,omitempty"`
+	Trigger           *PipelineTrigger    `json:"trigger,omitempty"`
+	State             *PipelineState      `json:"state,omitempty"`
+	Variables         []*PipelineVariable `json:"variables,omitempty"`
+	CreatedOn         *time.Time          `json:"created_on,omitempty"`
+	CompletedOn       *time.Time          `json:"completed_on,omitempty"`
+	BuildSecondsUsed  *int64              `json:"build_seconds_used,omitempty"`
+	DurationInSeconds *int64              `json:"duration_in_seconds,omitempty"`
+	Links             *PipelineLinks      `json:"links,omitempty"`
+}
+
+// PipelineLinks represents the "links" object in a Bitbucket pipeline.
+type PipelineLinks struct {
+	Self  *Link `json:"self,omitempty"`
+	Steps *Link `json:"steps,omitempty"`
+}
+
+// PipelineTarget represents what a pipeline runs against: a branch or tag, a commit or a pull request.
+//
+// To trigger a pipeline on a branch, set Type to PipelineRefTargetType, RefType to "branch" and RefName to the branch name.
+// To trigger on a commit, set Type to PipelineCommitTargetType and Commit to a commit with its Type set to "commit" and its Hash.
+// To run a custom pipeline, also set Selector with Type PipelineSelectorCustom and Pattern set to the pipeline name.
+type PipelineTarget struct {
+	Type        *string           `json:"type,omitempty"`
+	RefType     *string           `json:"ref_type,omitempty"`
+	RefName     *string           `json:"ref_name,omitempty"`
+	Selector    *PipelineSelector `json:"selector,omitempty"`
+	Commit      *Commit           `json:"commit,omitempty"`
+	Source      *string           `json:"source,omitempty"`
+	Destination *string           `json:"destination,omitempty"`
+	PullRequest *PullRequest      `json:"pullrequest,omitempty"`
+}
+
+// PipelineSelector selects which pipeline definition in bitbucket-pipelines.yml to run.
+type PipelineSelector struct {
+	Type    *string `json:"type,omitempty"`
+	Pattern *string `json:"pattern,omitempty"`
+}
+
+// PipelineTrigger represents what caused a pipeline to run, such as a push or a manual trigger.
+type PipelineTrigger struct {
+	Type *string `json:"type,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// PipelineState represents the state of a pipeline or pipeline step.
+//
+// Name is one of PENDING, IN_PROGRESS or COMPLETED. Once completed, Result holds the outcome
+// such as SUCCESSFUL, FAILED, ERROR or STOPPED. While in progress, Stage holds details such as RUNNING or PAUSED.
+type PipelineState struct {
+	Type   *string              `json:"type,omitempty"`
+	Name   *string              `json:"name,omitempty"`
+	Result *PipelineStateDetail `json:"result,omitempty"`
+	Stage  *PipelineStateDetail `json:"stage,omitempty"`
+}
+
+// PipelineStateDetail represents the result or stage of a pipeline state.
+type PipelineStateDetail struct {
+	Type *string `json:"type,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// PipelineVariable represents a variable passed to a pipeline run.
+type PipelineVariable struct {
+	Type    *string `json:"type,omitempty"`
+	UUID    *string `json:"uuid,omitempty"`
+	Key     *string `json:"key,omitempty"`
+	Value   *string `json:"value,omitempty"`
+	Secured *bool   `json:"secured,omitempty"`
+}
+
+// PipelineRequest represents a request to trigger a pipeline.
+type PipelineRequest struct {
+	Target    *PipelineTarget     `json:"target,omitempty"`
+	Variables []*PipelineVariable `json:"variables,omitempty"`
+}
+
+// PipelineListOpts represents the filters available when listing pipelines.
+type PipelineListOpts struct {
+	CreatorUUID     string `url:"creator.uuid,omitempty"`
+	TargetRefType   string `url:"target.ref_type,omitempty"`
+	TargetRefName   string `url:"target.ref_name,omitempty"`
+	TargetBranch    string `url:"target.branch,omitempty"`
+	TargetCommit    string `url:"target.commit.hash,omitempty"`
+	SelectorPattern string `url:"target.selector.pattern,omitempty"`
+	SelectorType    string `url:"target.selector.type,omitempty"`
+	TriggerType     string `url:"trigger_type,omitempty"`
+	Status          string `url:"status,omitempty"`
+
+	// CreatedOn filters pipelines created on the given date, formatted as an ISO-8601 date.
+	CreatedOn string `url:"created_on,omitempty"`
+}
+
+// List returns the pipelines of a repository.
+//
+// Use PipelineListOpts to filter the results and FilterSortOpts.Sort, such as "-created_on", to order them.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-get
+func (p *PipelinesService) List(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Pipelines, *simpleresty.Response, error) {
+	result := new(Pipelines)
+	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pipelines/", owner, repoSlug), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := p.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// Get returns a single pipeline.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-pipeline-uuid-get
+func (p *PipelinesService) Get(ctx context.Context, owner, repoSlug, pipelineUUID string, opts ...interface{}) (*Pipeline, *simpleresty.Response, error) {
+	result := new(Pipeline)
+	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pipelines/%s", owner, repoSlug, pipelineUUID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := p.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// Trigger starts a new pipeline run for a branch, tag, commit or custom pipeline.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-post
+func (p *PipelinesService) Trigger(ctx context.Context, owner, repoSlug string, po *PipelineRequest) (*Pipeline, *simpleresty.Response, error) {
+	result := new(Pipeline)
+	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pipelines/", owner, repoSlug)
+	response, err := p.client.post(ctx, urlStr, result, po)
+
+	return result, response, err
+}
+
+// Stop signals a running pipeline to stop.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-pipeline-uuid-stoppipeline-post
+func (p *PipelinesService) Stop(ctx context.Context, owner, repoSlug, pipelineUUID string) (*simpleresty.Response, error) {
+	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pipelines/%s/stopPipeline", owner, repoSlug, pipelineUUID)
+	response, err := p.client.post(ctx, urlStr, nil, nil)
+
+	return response, err
+}
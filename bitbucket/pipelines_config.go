@@ -0,0 +1,70 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+)
+
+// PipelinesConfigService handles communication with the pipelines configuration related methods
+// of the Bitbucket API. This covers enabling pipelines on a repository as well as managing
+// pipeline variables, SSH keys, known hosts and schedules.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/
+type PipelinesConfigService service
+
+// PipelinesConfig represents the pipelines configuration of a repository.
+type PipelinesConfig struct {
+	Type       *string     `json:"type,omitempty"`
+	Enabled    *bool       `json:"enabled,omitempty"`
+	Repository *Repository `json:"repository,omitempty"`
+}
+
+// PipelinesConfigRequest represents a request to enable or disable pipelines on a repository.
+type PipelinesConfigRequest struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// PipelineBuildNumber represents the next build number of a repository's pipelines.
+type PipelineBuildNumber struct {
+	Type *string `json:"type,omitempty"`
+	Next *int64  `json:"next,omitempty"`
+}
+
+// Get returns the pipelines configuration of a repository.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-get
+func (pc *PipelinesConfigService) Get(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*PipelinesConfig, *simpleresty.Response, error) {
+	result := new(PipelinesConfig)
+	urlStr, urlStrErr := pc.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pipelines_config", owner, repoSlug), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := pc.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// Update enables or disables pipelines on a repository.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-put
+func (pc *PipelinesConfigService) Update(ctx context.Context, owner, repoSlug string, po *PipelinesConfigRequest) (*PipelinesConfig, *simpleresty.Response, error) {
+	result := new(PipelinesConfig)
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/pipelines_config", owner, repoSlug)
+	response, err := pc.client.put(ctx, urlStr, result, po)
+
+	return result, response, err
+}
+
+// UpdateNextBuildNumber sets the build number of the next pipeline run. It must be greater than the current build number.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-build-number-put
+func (pc *PipelinesConfigService) UpdateNextBuildNumber(ctx context.Context, owner, repoSlug string, next int64) (*PipelineBuildNumber, *simpleresty.Response, error) {
+	result := new(PipelineBuildNumber)
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/pipelines_config/build_number", owner, repoSlug)
+	response, err := pc.client.put(ctx, urlStr, result, &PipelineBuildNumber{Next: &next})
+
+	return result, response, err
+}
@@ -0,0 +1,129 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"time"
+)
+
+// PipelineSchedules represents a collection of pipeline schedules.
+type PipelineSchedules struct {
+	PaginationInfo
+
+	Values []*PipelineSchedule `json:"values,omitempty"`
+}
+
+// PipelineSchedule represents a schedule that periodically triggers a pipeline.
+type PipelineSchedule struct {
+	Type        *string         `json:"type,omitempty"`
+	UUID        *string         `json:"uuid,omitempty"`
+	Enabled     *bool           `json:"enabled,omitempty"`
+	Target      *PipelineTarget `json:"target,omitempty"`
+	CronPattern *string         `json:"cron_pattern,omitempty"`
+	CreatedOn   *time.Time      `json:"created_on,omitempty"`
+	UpdatedOn   *time.Time      `json:"updated_on,omitempty"`
+}
+
+// PipelineScheduleRequest represents a request to create/update a pipeline schedule.
+//
+// A schedule's target and cron pattern can only be set on creation; updates may only change Enabled.
+// The target must be a PipelineRefTargetType target on a branch with a selector.
+type PipelineScheduleRequest struct {
+	Target      *PipelineTarget `json:"target,omitempty"`
+	CronPattern *string         `json:"cron_pattern,omitempty"`
+	Enabled     *bool           `json:"enabled,omitempty"`
+}
+
+// PipelineScheduleExecutions represents a collection of pipeline schedule executions.
+type PipelineScheduleExecutions struct {
+	PaginationInfo
+
+	Values []*PipelineScheduleExecution `json:"values,omitempty"`
+}
+
+// PipelineScheduleExecution represents a single run of a pipeline schedule.
+type PipelineScheduleExecution struct {
+	Type     *string   `json:"type,omitempty"`
+	Pipeline *Pipeline `json:"pipeline,omitempty"`
+}
+
+// ListSchedules returns the pipeline schedules of a repository.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-schedules-get
+func (pc *PipelinesConfigService) ListSchedules(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*PipelineSchedules, *simpleresty.Response, error) {
+	result := new(PipelineSchedules)
+	urlStr, urlStrErr := pc.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pipelines_config/schedules/", owner, repoSlug), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := pc.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// GetSchedule returns a single pipeline schedule.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-schedules-schedule-uuid-get
+func (pc *PipelinesConfigService) GetSchedule(ctx context.Context, owner, repoSlug, scheduleUUID string, opts ...interface{}) (*PipelineSchedule, *simpleresty.Response, error) {
+	result := new(PipelineSchedule)
+	urlStr, urlStrErr := pc.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pipelines_config/schedules/%s", owner, repoSlug, scheduleUUID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := pc.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// CreateSchedule creates a pipeline schedule.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-schedules-post
+func (pc *PipelinesConfigService) CreateSchedule(ctx context.Context, owner, repoSlug string, so *PipelineScheduleRequest) (*PipelineSchedule, *simpleresty.Response, error) {
+	result := new(PipelineSchedule)
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/pipelines_config/schedules/", owner, repoSlug)
+	response, err := pc.client.post(ctx, urlStr, result, so)
+
+	return result, response, err
+}
+
+// UpdateSchedule updates a pipeline schedule.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-schedules-schedule-uuid-put
+func (pc *PipelinesConfigService) UpdateSchedule(ctx context.Context, owner, repoSlug, scheduleUUID string, so *PipelineScheduleRequest) (*PipelineSchedule, *simpleresty.Response, error) {
+	result := new(PipelineSchedule)
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/pipelines_config/schedules/%s", owner, repoSlug, scheduleUUID)
+	response, err := pc.client.put(ctx, urlStr, result, so)
+
+	return result, response, err
+}
+
+// DeleteSchedule deletes a pipeline schedule.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-schedules-schedule-uuid-delete
+func (pc *PipelinesConfigService) DeleteSchedule(ctx context.Context, owner, repoSlug, scheduleUUID string) (*simpleresty.Response, error) {
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/pipelines_config/schedules/%s", owner, repoSlug, scheduleUUID)
+	response, err := pc.client.delete(ctx, urlStr, nil, nil)
+
+	return response, err
+}
+
+// ListScheduleExecutions returns the pipeline runs triggered by a schedule.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-schedules-schedule-uuid-executions-get
+func (pc *PipelinesConfigService) ListScheduleExecutions(ctx context.Context, owner, repoSlug, scheduleUUID string, opts ...interface{}) (*PipelineScheduleExecutions, *simpleresty.Response, error) {
+	result := new(PipelineScheduleExecutions)
+	urlStr, urlStrErr := pc.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pipelines_config/schedules/%s/executions", owner, repoSlug, scheduleUUID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := pc.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
@@ -0,0 +1,148 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+)
+
+// PipelineSSHKeyPair represents the SSH key pair used by pipelines to access other services.
+//
+// The private key is never returned by the API.
+type PipelineSSHKeyPair struct {
+	Type       *string `json:"type,omitempty"`
+	PrivateKey *string `json:"private_key,omitempty"`
+	PublicKey  *string `json:"public_key,omitempty"`
+}
+
+// PipelineSSHKeyPairRequest represents a request to set the pipelines SSH key pair.
+type PipelineSSHKeyPairRequest struct {
+	PrivateKey *string `json:"private_key,omitempty"`
+	PublicKey  *string `json:"public_key,omitempty"`
+}
+
+// PipelineKnownHosts represents a collection of known hosts.
+type PipelineKnownHosts struct {
+	PaginationInfo
+
+	Values []*PipelineKnownHost `json:"values,omitempty"`
+}
+
+// PipelineKnownHost represents a host that pipelines trust when connecting over SSH.
+type PipelineKnownHost struct {
+	Type      *string               `json:"type,omitempty"`
+	UUID      *string               `json:"uuid,omitempty"`
+	Hostname  *string               `json:"hostname,omitempty"`
+	PublicKey *PipelineSSHPublicKey `json:"public_key,omitempty"`
+}
+
+// PipelineSSHPublicKey represents the public key of a known host.
+type PipelineSSHPublicKey struct {
+	Type              *string `json:"type,omitempty"`
+	KeyType           *string `json:"key_type,omitempty"`
+	Key               *string `json:"key,omitempty"`
+	MD5Fingerprint    *string `json:"md5_fingerprint,omitempty"`
+	SHA256Fingerprint *string `json:"sha256_fingerprint,omitempty"`
+}
+
+// PipelineKnownHostRequest represents a request to create/update a known host.
+type PipelineKnownHostRequest struct {
+	Hostname  *string               `json:"hostname,omitempty"`
+	PublicKey *PipelineSSHPublicKey `json:"public_key,omitempty"`
+}
+
+// GetSSHKeyPair returns the public half of the repository's pipelines SSH key pair.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-ssh-key-pair-get
+func (pc *PipelinesConfigService) GetSSHKeyPair(ctx context.Context, owner, repoSlug string) (*PipelineSSHKeyPair, *simpleresty.Response, error) {
+	result := new(PipelineSSHKeyPair)
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/pipelines_config/ssh/key_pair", owner, repoSlug)
+	response, err := pc.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// UpdateSSHKeyPair creates or replaces the repository's pipelines SSH key pair.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-ssh-key-pair-put
+func (pc *PipelinesConfigService) UpdateSSHKeyPair(ctx context.Context, owner, repoSlug string, ko *PipelineSSHKeyPairRequest) (*PipelineSSHKeyPair, *simpleresty.Response, error) {
+	result := new(PipelineSSHKeyPair)
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/pipelines_config/ssh/key_pair", owner, repoSlug)
+	response, err := pc.client.put(ctx, urlStr, result, ko)
+
+	return result, response, err
+}
+
+// DeleteSSHKeyPair deletes the repository's pipelines SSH key pair.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-ssh-key-pair-delete
+func (pc *PipelinesConfigService) DeleteSSHKeyPair(ctx context.Context, owner, repoSlug string) (*simpleresty.Response, error) {
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/pipelines_config/ssh/key_pair", owner, repoSlug)
+	response, err := pc.client.delete(ctx, urlStr, nil, nil)
+
+	return response, err
+}
+
+// ListKnownHosts returns the known hosts of a repository's pipelines.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-ssh-known-hosts-get
+func (pc *PipelinesConfigService) ListKnownHosts(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*PipelineKnownHosts, *simpleresty.Response, error) {
+	result := new(PipelineKnownHosts)
+	urlStr, urlStrErr := pc.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pipelines_config/ssh/known_hosts/", owner, repoSlug), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := pc.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// GetKnownHost returns a single known host.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-ssh-known-hosts-known-host-uuid-get
+func (pc *PipelinesConfigService) GetKnownHost(ctx context.Context, owner, repoSlug, knownHostUUID string, opts ...interface{}) (*PipelineKnownHost, *simpleresty.Response, error) {
+	result := new(PipelineKnownHost)
+	urlStr, urlStrErr := pc.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pipelines_config/ssh/known_hosts/%s", owner, repoSlug, knownHostUUID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := pc.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// CreateKnownHost adds a known host to a repository's pipelines.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-ssh-known-hosts-post
+func (pc *PipelinesConfigService) CreateKnownHost(ctx context.Context, owner, repoSlug string, ko *PipelineKnownHostRequest) (*PipelineKnownHost, *simpleresty.Response, error) {
+	result := new(PipelineKnownHost)
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/pipelines_config/ssh/known_hosts/", owner, repoSlug)
+	response, err := pc.client.post(ctx, urlStr, result, ko)
+
+	return result, response, err
+}
+
+// UpdateKnownHost updates a known host.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-ssh-known-hosts-known-host-uuid-put
+func (pc *PipelinesConfigService) UpdateKnownHost(ctx context.Context, owner, repoSlug, knownHostUUID string, ko *PipelineKnownHostRequest) (*PipelineKnownHost, *simpleresty.Response, error) {
+	result := new(PipelineKnownHost)
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/pipelines_config/ssh/known_hosts/%s", owner, repoSlug, knownHostUUID)
+	response, err := pc.client.put(ctx, urlStr, result, ko)
+
+	return result, response, err
+}
+
+// DeleteKnownHost deletes a known host.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-ssh-known-hosts-known-host-uuid-delete
+func (pc *PipelinesConfigService) DeleteKnownHost(ctx context.Context, owner, repoSlug, knownHostUUID string) (*simpleresty.Response, error) {
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/pipelines_config/ssh/known_hosts/%s", owner, repoSlug, knownHostUUID)
+	response, err := pc.client.delete(ctx, urlStr, nil, nil)
+
+	return response, err
+}
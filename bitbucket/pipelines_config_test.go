@@ -0,0 +1,200 @@
+package bitbucket
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipelinesConfigService_Variables(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusCreated, `{"uuid":"{v-1}","key":"TOKEN","secured":true}`)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	key, value, secured := "TOKEN", "s3cret", true
+	request := &PipelineVariableRequest{Key: &key, Value: &value, Secured: &secured}
+
+	tests := []struct {
+		name   string
+		call   func() (*PipelineVariable, error)
+		method string
+		path   string
+	}{
+		{"CreateVariable", func() (*PipelineVariable, error) {
+			v, _, err := client.PipelinesConfig.CreateVariable(ctx, "owner", "repo", request)
+			return v, err
+		}, "POST", "/repositories/owner/repo/pipelines_config/variables/"},
+		{"UpdateVariable", func() (*PipelineVariable, error) {
+			v, _, err := client.PipelinesConfig.UpdateVariable(ctx, "owner", "repo", "{v-1}", request)
+			return v, err
+		}, "PUT", "/repositories/owner/repo/pipelines_config/variables/{v-1}"},
+		{"CreateWorkspaceVariable", func() (*PipelineVariable, error) {
+			v, _, err := client.PipelinesConfig.CreateWorkspaceVariable(ctx, "workspace", request)
+			return v, err
+		}, "POST", "/workspaces/workspace/pipelines-config/variables"},
+		{"UpdateWorkspaceVariable", func() (*PipelineVariable, error) {
+			v, _, err := client.PipelinesConfig.UpdateWorkspaceVariable(ctx, "workspace", "{v-1}", request)
+			return v, err
+		}, "PUT", "/workspaces/workspace/pipelines-config/variables/{v-1}"},
+		{"CreateDeploymentVariable", func() (*PipelineVariable, error) {
+			v, _, err := client.PipelinesConfig.CreateDeploymentVariable(ctx, "owner", "repo", "{e-1}", request)
+			return v, err
+		}, "POST", "/repositories/owner/repo/deployments_config/environments/{e-1}/variables"},
+		{"UpdateDeploymentVariable", func() (*PipelineVariable, error) {
+			v, _, err := client.PipelinesConfig.UpdateDeploymentVariable(ctx, "owner", "repo", "{e-1}", "{v-1}", request)
+			return v, err
+		}, "PUT", "/repositories/owner/repo/deployments_config/environments/{e-1}/variables/{v-1}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			variable, callErr := tt.call()
+			assert.Nil(t, callErr)
+			assert.Equal(t, tt.method, captured.Method)
+			assert.Equal(t, tt.path, captured.Path)
+			assert.Equal(t, map[string]interface{}{"key": "TOKEN", "value": "s3cret", "secured": true}, captured.Body)
+
+			// The value of a secured variable is not echoed back.
+			assert.True(t, variable.GetSecured())
+			assert.Equal(t, "", variable.GetValue())
+		})
+	}
+}
+
+func TestPipelinesConfigService_UnsecuredVariable(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusCreated, `{"uuid":"{v-2}","key":"ENV","value":"staging","secured":false}`)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	key, value, secured := "ENV", "staging", false
+	variable, _, createErr := client.PipelinesConfig.CreateVariable(context.Background(), "owner", "repo",
+		&PipelineVariableRequest{Key: &key, Value: &value, Secured: &secured})
+	assert.Nil(t, createErr)
+	assert.Equal(t, map[string]interface{}{"key": "ENV", "value": "staging", "secured": false}, captured.Body)
+	assert.Equal(t, "staging", variable.GetValue())
+}
+
+func TestPipelinesConfigService_Update(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusOK, `{"enabled":true,"next":42}`)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	enabled := true
+	config, _, updateErr := client.PipelinesConfig.Update(ctx, "owner", "repo", &PipelinesConfigRequest{Enabled: &enabled})
+	assert.Nil(t, updateErr)
+	assert.True(t, config.GetEnabled())
+	assert.Equal(t, capturedRequest{Method: "PUT", Path: "/repositories/owner/repo/pipelines_config",
+		Body: map[string]interface{}{"enabled": true}}, captured)
+
+	buildNumber, _, buildErr := client.PipelinesConfig.UpdateNextBuildNumber(ctx, "owner", "repo", 42)
+	assert.Nil(t, buildErr)
+	assert.Equal(t, int64(42), buildNumber.GetNext())
+	assert.Equal(t, capturedRequest{Method: "PUT", Path: "/repositories/owner/repo/pipelines_config/build_number",
+		Body: map[string]interface{}{"next": float64(42)}}, captured)
+}
+
+func TestPipelinesConfigService_SSHKeyPair(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusOK, `{"type":"pipeline_ssh_key_pair","public_key":"ssh-rsa AAAA"}`)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	privateKey, publicKey := "-----BEGIN KEY-----", "ssh-rsa AAAA"
+	keyPair, _, keyErr := client.PipelinesConfig.UpdateSSHKeyPair(context.Background(), "owner", "repo",
+		&PipelineSSHKeyPairRequest{PrivateKey: &privateKey, PublicKey: &publicKey})
+	assert.Nil(t, keyErr)
+	assert.Equal(t, publicKey, keyPair.GetPublicKey())
+	assert.Equal(t, "", keyPair.GetPrivateKey())
+	assert.Equal(t, capturedRequest{Method: "PUT", Path: "/repositories/owner/repo/pipelines_config/ssh/key_pair",
+		Body: map[string]interface{}{"private_key": privateKey, "public_key": publicKey}}, captured)
+}
+
+func TestPipelinesConfigService_KnownHosts(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusCreated,
+		`{"uuid":"{h-1}","hostname":"example.com","public_key":{"key_type":"ssh-ed25519","key":"AAAA"}}`)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	hostname, keyType, key := "example.com", "ssh-ed25519", "AAAA"
+	request := &PipelineKnownHostRequest{Hostname: &hostname, PublicKey: &PipelineSSHPublicKey{KeyType: &keyType, Key: &key}}
+	body := map[string]interface{}{
+		"hostname":   "example.com",
+		"public_key": map[string]interface{}{"key_type": "ssh-ed25519", "key": "AAAA"},
+	}
+
+	host, _, createErr := client.PipelinesConfig.CreateKnownHost(ctx, "owner", "repo", request)
+	assert.Nil(t, createErr)
+	assert.Equal(t, "{h-1}", host.GetUUID())
+	assert.Equal(t, "ssh-ed25519", host.GetPublicKey().GetKeyType())
+	assert.Equal(t, capturedRequest{Method: "POST", Path: "/repositories/owner/repo/pipelines_config/ssh/known_hosts/",
+		Body: body}, captured)
+
+	_, _, updateErr := client.PipelinesConfig.UpdateKnownHost(ctx, "owner", "repo", "{h-1}", request)
+	assert.Nil(t, updateErr)
+	assert.Equal(t, capturedRequest{Method: "PUT", Path: "/repositories/owner/repo/pipelines_config/ssh/known_hosts/{h-1}",
+		Body: body}, captured)
+}
+
+func TestPipelinesConfigService_Schedules(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusCreated,
+		`{"uuid":"{s-1}","enabled":true,"cron_pattern":"0 0 12 * * ? *","target":{"ref_name":"main"}}`)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	targetType, refType, refName := PipelineRefTargetType, "branch", "main"
+	selectorType, pattern := PipelineSelectorBranches, "main"
+	cron, enabled := "0 0 12 * * ? *", true
+
+	schedule, _, createErr := client.PipelinesConfig.CreateSchedule(ctx, "owner", "repo", &PipelineScheduleRequest{
+		Target: &PipelineTarget{
+			Type:     &targetType,
+			RefType:  &refType,
+			RefName:  &refName,
+			Selector: &PipelineSelector{Type: &selectorType, Pattern: &pattern},
+		},
+		CronPattern: &cron,
+		Enabled:     &enabled,
+	})
+	assert.Nil(t, createErr)
+	assert.Equal(t, "{s-1}", schedule.GetUUID())
+	assert.Equal(t, capturedRequest{Method: "POST", Path: "/repositories/owner/repo/pipelines_config/schedules/",
+		Body: map[string]interface{}{
+			"target": map[string]interface{}{
+				"type":     "pipeline_ref_target",
+				"ref_type": "branch",
+				"ref_name": "main",
+				"selector": map[string]interface{}{"type": "branches", "pattern": "main"},
+			},
+			"cron_pattern": cron,
+			"enabled":      true,
+		}}, captured)
+
+	disabled := false
+	_, _, updateErr := client.PipelinesConfig.UpdateSchedule(ctx, "owner", "repo", "{s-1}", &PipelineScheduleRequest{Enabled: &disabled})
+	assert.Nil(t, updateErr)
+	assert.Equal(t, capturedRequest{Method: "PUT", Path: "/repositories/owner/repo/pipelines_config/schedules/{s-1}",
+		Body: map[string]interface{}{"enabled": false}}, captured)
+}
@@ -0,0 +1,202 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+)
+
+// PipelineVariables represents a collection of pipeline variables.
+type PipelineVariables struct {
+	PaginationInfo
+
+	Values []*PipelineVariable `json:"values,omitempty"`
+}
+
+// PipelineVariableRequest represents a request to create/update a pipeline variable.
+//
+// The value of a secured variable is never returned by the API once it is set.
+type PipelineVariableRequest struct {
+	Key     *string `json:"key,omitempty"`
+	Value   *string `json:"value,omitempty"`
+	Secured *bool   `json:"secured,omitempty"`
+}
+
+// ListVariables returns the pipeline variables of a repository.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-variables-get
+func (pc *PipelinesConfigService) ListVariables(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*PipelineVariables, *simpleresty.Response, error) {
+	result := new(PipelineVariables)
+	urlStr, urlStrErr := pc.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pipelines_config/variables/", owner, repoSlug), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := pc.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// GetVariable returns a single pipeline variable of a repository.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-variables-variable-uuid-get
+func (pc *PipelinesConfigService) GetVariable(ctx context.Context, owner, repoSlug, variableUUID string, opts ...interface{}) (*PipelineVariable, *simpleresty.Response, error) {
+	result := new(PipelineVariable)
+	urlStr, urlStrErr := pc.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pipelines_config/variables/%s", owner, repoSlug, variableUUID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := pc.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// CreateVariable creates a pipeline variable on a repository.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-variables-post
+func (pc *PipelinesConfigService) CreateVariable(ctx context.Context, owner, repoSlug string, vo *PipelineVariableRequest) (*PipelineVariable, *simpleresty.Response, error) {
+	result := new(PipelineVariable)
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/pipelines_config/variables/", owner, repoSlug)
+	response, err := pc.client.post(ctx, urlStr, result, vo)
+
+	return result, response, err
+}
+
+// UpdateVariable updates a pipeline variable of a repository.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-variables-variable-uuid-put
+func (pc *PipelinesConfigService) UpdateVariable(ctx context.Context, owner, repoSlug, variableUUID string, vo *PipelineVariableRequest) (*PipelineVariable, *simpleresty.Response, error) {
+	result := new(PipelineVariable)
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/pipelines_config/variables/%s", owner, repoSlug, variableUUID)
+	response, err := pc.client.put(ctx, urlStr, result, vo)
+
+	return result, response, err
+}
+
+// DeleteVariable deletes a pipeline variable from a repository.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-variables-variable-uuid-delete
+func (pc *PipelinesConfigService) DeleteVariable(ctx context.Context, owner, repoSlug, variableUUID string) (*simpleresty.Response, error) {
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/pipelines_config/variables/%s", owner, repoSlug, variableUUID)
+	response, err := pc.client.delete(ctx, urlStr, nil, nil)
+
+	return response, err
+}
+
+// ListWorkspaceVariables returns the pipeline variables shared by every repository in a workspace.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-workspaces-workspace-pipelines-config-variables-get
+func (pc *PipelinesConfigService) ListWorkspaceVariables(ctx context.Context, workspace string, opts ...interface{}) (*PipelineVariables, *simpleresty.Response, error) {
+	result := new(PipelineVariables)
+	urlStr, urlStrErr := pc.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/workspaces/%s/pipelines-config/variables", workspace), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := pc.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// GetWorkspaceVariable returns a single workspace pipeline variable.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-workspaces-workspace-pipelines-config-variables-variable-uuid-get
+func (pc *PipelinesConfigService) GetWorkspaceVariable(ctx context.Context, workspace, variableUUID string, opts ...interface{}) (*PipelineVariable, *simpleresty.Response, error) {
+	result := new(PipelineVariable)
+	urlStr, urlStrErr := pc.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/workspaces/%s/pipelines-config/variables/%s", workspace, variableUUID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := pc.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// CreateWorkspaceVariable creates a pipeline variable on a workspace.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-workspaces-workspace-pipelines-config-variables-post
+func (pc *PipelinesConfigService) CreateWorkspaceVariable(ctx context.Context, workspace string, vo *PipelineVariableRequest) (*PipelineVariable, *simpleresty.Response, error) {
+	result := new(PipelineVariable)
+	urlStr := pc.client.http.RequestURL("/workspaces/%s/pipelines-config/variables", workspace)
+	response, err := pc.client.post(ctx, urlStr, result, vo)
+
+	return result, response, err
+}
+
+// UpdateWorkspaceVariable updates a workspace pipeline variable.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-workspaces-workspace-pipelines-config-variables-variable-uuid-put
+func (pc *PipelinesConfigService) UpdateWorkspaceVariable(ctx context.Context, workspace, variableUUID string, vo *PipelineVariableRequest) (*PipelineVariable, *simpleresty.Response, error) {
+	result := new(PipelineVariable)
+	urlStr := pc.client.http.RequestURL("/workspaces/%s/pipelines-config/variables/%s", workspace, variableUUID)
+	response, err := pc.client.put(ctx, urlStr, result, vo)
+
+	return result, response, err
+}
+
+// DeleteWorkspaceVariable deletes a workspace pipeline variable.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-workspaces-workspace-pipelines-config-variables-variable-uuid-delete
+func (pc *PipelinesConfigService) DeleteWorkspaceVariable(ctx context.Context, workspace, variableUUID string) (*simpleresty.Response, error) {
+	urlStr := pc.client.http.RequestURL("/workspaces/%s/pipelines-config/variables/%s", workspace, variableUUID)
+	response, err := pc.client.delete(ctx, urlStr, nil, nil)
+
+	return response, err
+}
+
+// ListDeploymentVariables returns the variables of a deployment environment.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-deployments-config-environments-environment-uuid-variables-get
+func (pc *PipelinesConfigService) ListDeploymentVariables(ctx context.Context, owner, repoSlug, environmentUUID string, opts ...interface{}) (*PipelineVariables, *simpleresty.Response, error) {
+	result := new(PipelineVariables)
+	urlStr, urlStrErr := pc.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/deployments_config/environments/%s/variables", owner, repoSlug, environmentUUID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := pc.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// CreateDeploymentVariable creates a variable on a deployment environment.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-deployments-config-environments-environment-uuid-variables-post
+func (pc *PipelinesConfigService) CreateDeploymentVariable(ctx context.Context, owner, repoSlug, environmentUUID string, vo *PipelineVariableRequest) (*PipelineVariable, *simpleresty.Response, error) {
+	result := new(PipelineVariable)
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/deployments_config/environments/%s/variables",
+		owner, repoSlug, environmentUUID)
+	response, err := pc.client.post(ctx, urlStr, result, vo)
+
+	return result, response, err
+}
+
+// UpdateDeploymentVariable updates a variable of a deployment environment.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-deployments-config-environments-environment-uuid-variables-variable-uuid-put
+func (pc *PipelinesConfigService) UpdateDeploymentVariable(ctx context.Context, owner, repoSlug, environmentUUID, variableUUID string, vo *PipelineVariableRequest) (*PipelineVariable, *simpleresty.Response, error) {
+	result := new(PipelineVariable)
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/deployments_config/environments/%s/variables/%s",
+		owner, repoSlug, environmentUUID, variableUUID)
+	response, err := pc.client.put(ctx, urlStr, result, vo)
+
+	return result, response, err
+}
+
+// DeleteDeploymentVariable deletes a variable from a deployment environment.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-deployments-config-environments-environment-uuid-variables-variable-uuid-delete
+func (pc *PipelinesConfigService) DeleteDeploymentVariable(ctx context.Context, owner, repoSlug, environmentUUID, variableUUID string) (*simpleresty.Response, error) {
+	urlStr := pc.client.http.RequestURL("/repositories/%s/%s/deployments_config/environments/%s/variables/%s",
+		owner, repoSlug, environmentUUID, variableUUID)
+	response, err := pc.client.delete(ctx, urlStr, nil, nil)
+
+	return response, err
+}
@@ -0,0 +1,91 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+)
+
+// PipelineTestReport represents the summary of the test reports of a pipeline step.
+type PipelineTestReport struct {
+	NumberOfSuccessfulTestCases *int64 `json:"number_of_successful_test_cases,omitempty"`
+	NumberOfFailedTestCases     *int64 `json:"number_of_failed_test_cases,omitempty"`
+	NumberOfErrorTestCases      *int64 `json:"number_of_error_test_cases,omitempty"`
+	NumberOfSkippedTestCases    *int64 `json:"number_of_skipped_test_cases,omitempty"`
+}
+
+// PipelineTestCases represents a collection of test cases.
+type PipelineTestCases struct {
+	PaginationInfo
+
+	Values []*PipelineTestCase `json:"values,omitempty"`
+}
+
+// PipelineTestCase represents a single test case reported by a pipeline step.
+type PipelineTestCase struct {
+	UUID               *string `json:"uuid,omitempty"`
+	Name               *string `json:"name,omitempty"`
+	FullyQualifiedName *string `json:"fully_qualified_name,omitempty"`
+	PackageName        *string `json:"package_name,omitempty"`
+	Status             *string `json:"status,omitempty"`
+	Duration           *string `json:"duration,omitempty"`
+}
+
+// PipelineTestCaseReasons represents a collection of test case reasons.
+type PipelineTestCaseReasons struct {
+	PaginationInfo
+
+	Values []*PipelineTestCaseReason `json:"values,omitempty"`
+}
+
+// PipelineTestCaseReason represents the output explaining why a test case failed.
+type PipelineTestCaseReason struct {
+	Message *string `json:"message,omitempty"`
+	Output  *string `json:"output,omitempty"`
+}
+
+// GetTestReport returns the test report summary of a pipeline step.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-pipeline-uuid-steps-step-uuid-test-reports-get
+func (p *PipelinesService) GetTestReport(ctx context.Context, owner, repoSlug, pipelineUUID, stepUUID string) (*PipelineTestReport, *simpleresty.Response, error) {
+	result := new(PipelineTestReport)
+	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pipelines/%s/steps/%s/test_reports",
+		owner, repoSlug, pipelineUUID, stepUUID)
+	response, err := p.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// ListTestCases returns the test cases reported by a pipeline step.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-pipeline-uuid-steps-step-uuid-test-reports-test-cases-get
+func (p *PipelinesService) ListTestCases(ctx context.Context, owner, repoSlug, pipelineUUID, stepUUID string, opts ...interface{}) (*PipelineTestCases, *simpleresty.Response, error) {
+	result := new(PipelineTestCases)
+	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pipelines/%s/steps/%s/test_reports/test_cases",
+			owner, repoSlug, pipelineUUID, stepUUID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := p.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// ListTestCaseReasons returns the reasons, such as the failure output, of a single test case.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-pipeline-uuid-steps-step-uuid-test-reports-test-cases-test-case-uuid-test-case-reasons-get
+func (p *PipelinesService) ListTestCaseReasons(ctx context.Context, owner, repoSlug, pipelineUUID, stepUUID, testCaseUUID string, opts ...interface{}) (*PipelineTestCaseReasons, *simpleresty.Response, error) {
+	result := new(PipelineTestCaseReasons)
+	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pipelines/%s/steps/%s/test_reports/test_cases/%s/test_case_reasons",
+			owner, repoSlug, pipelineUUID, stepUUID, testCaseUUID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := p.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
@@ -0,0 +1,113 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"time"
+)
+
+// PipelineSteps represents a collection of pipeline steps.
+type PipelineSteps struct {
+	PaginationInfo
+
+	Values []*PipelineStep `json:"values,omitempty"`
+}
+
+// PipelineStep represents a single step of a pipeline run.
+type PipelineStep struct {
+	Type             *string                `json:"type,omitempty"`
+	UUID             *string                `json:"uuid,omitempty"`
+	Name             *string                `json:"name,omitempty"`
+	Pipeline         *Pipeline              `json:"pipeline,omitempty"`
+	State            *PipelineState         `json:"state,omitempty"`
+	Image            *PipelineImage         `json:"image,omitempty"`
+	SetupCommands    []*PipelineCommand     `json:"setup_commands,omitempty"`
+	ScriptCommands   []*PipelineCommand     `json:"script_commands,omitempty"`
+	TeardownCommands []*PipelineCommand     `json:"teardown_commands,omitempty"`
+	StartedOn        *time.Time             `json:"started_on,omitempty"`
+	CompletedOn      *time.Time             `json:"completed_on,omitempty"`
+	DurationInSecs   *int64                 `json:"duration_in_seconds,omitempty"`
+	BuildSecondsUsed *int64                 `json:"build_seconds_used,omitempty"`
+	MaxTime          *int64                 `json:"maxTime,omitempty"`
+	RunNumber        *int64                 `json:"run_number,omitempty"`
+	Trigger          *PipelineStepTrigger   `json:"trigger,omitempty"`
+	Environment      *PipelineStepReference `json:"environment,omitempty"`
+}
+
+// PipelineImage represents the Docker image a pipeline step runs in.
+type PipelineImage struct {
+	Name     *string `json:"name,omitempty"`
+	Username *string `json:"username,omitempty"`
+	Email    *string `json:"email,omitempty"`
+}
+
+// PipelineCommand represents a command executed by a pipeline step.
+type PipelineCommand struct {
+	Name    *string `json:"name,omitempty"`
+	Command *string `json:"command,omitempty"`
+	Action  *string `json:"action,omitempty"`
+}
+
+// PipelineStepTrigger represents whether a step runs automatically or manually.
+type PipelineStepTrigger struct {
+	Type *string `json:"type,omitempty"`
+}
+
+// PipelineStepReference represents a reference to another object, such as the deployment environment of a step.
+type PipelineStepReference struct {
+	Type *string `json:"type,omitempty"`
+	UUID *string `json:"uuid,omitempty"`
+}
+
+// ListSteps returns the steps of a pipeline.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-pipeline-uuid-steps-get
+func (p *PipelinesService) ListSteps(ctx context.Context, owner, repoSlug, pipelineUUID string, opts ...interface{}) (*PipelineSteps, *simpleresty.Response, error) {
+	result := new(PipelineSteps)
+	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pipelines/%s/steps/", owner, repoSlug, pipelineUUID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := p.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// GetStep returns a single step of a pipeline.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-pipeline-uuid-steps-step-uuid-get
+func (p *PipelinesService) GetStep(ctx context.Context, owner, repoSlug, pipelineUUID, stepUUID string, opts ...interface{}) (*PipelineStep, *simpleresty.Response, error) {
+	result := new(PipelineStep)
+	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pipelines/%s/steps/%s", owner, repoSlug, pipelineUUID, stepUUID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := p.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// GetStepLog returns the raw log output of a pipeline step.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-pipeline-uuid-steps-step-uuid-log-get
+func (p *PipelinesService) GetStepLog(ctx context.Context, owner, repoSlug, pipelineUUID, stepUUID string) (*bytes.Buffer, *simpleresty.Response, error) {
+	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pipelines/%s/steps/%s/log", owner, repoSlug, pipelineUUID, stepUUID)
+
+	req := p.client.newRequest(ctx)
+	req.Method = simpleresty.GetMethod
+	req.URL = urlStr
+	req.SetHeader("Accept", "application/octet-stream")
+
+	response, reqErr := p.client.dispatch(req)
+	if reqErr != nil {
+		return nil, response, reqErr
+	}
+
+	return bytes.NewBuffer(response.Resp.Body()), response, nil
+}
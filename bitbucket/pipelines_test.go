@@ -0,0 +1,71 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipelinesService_Trigger(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/repositories/owner/repo/pipelines/", r.URL.Path)
+		json.NewDecoder(r.Body).Decode(&body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"uuid":"{p-1}","build_number":7,"state":{"name":"PENDING"}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	targetType, refType, refName := PipelineRefTargetType, "branch", "main"
+	selectorType, pattern := PipelineSelectorCustom, "deploy"
+	key, value := "ENV", "staging"
+
+	pipeline, _, triggerErr := client.Pipelines.Trigger(context.Background(), "owner", "repo", &PipelineRequest{
+		Target: &PipelineTarget{
+			Type:     &targetType,
+			RefType:  &refType,
+			RefName:  &refName,
+			Selector: &PipelineSelector{Type: &selectorType, Pattern: &pattern},
+		},
+		Variables: []*PipelineVariable{{Key: &key, Value: &value}},
+	})
+	assert.Nil(t, triggerErr)
+	assert.Equal(t, int64(7), pipeline.GetBuildNumber())
+	assert.Equal(t, "PENDING", pipeline.GetState().GetName())
+
+	assert.Equal(t, map[string]interface{}{
+		"target": map[string]interface{}{
+			"type":     "pipeline_ref_target",
+			"ref_type": "branch",
+			"ref_name": "main",
+			"selector": map[string]interface{}{"type": "custom", "pattern": "deploy"},
+		},
+		"variables": []interface{}{map[string]interface{}{"key": "ENV", "value": "staging"}},
+	}, body)
+}
+
+func TestPipelinesService_GetStepLog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repositories/owner/repo/pipelines/%7Bp-1%7D/steps/%7Bs-1%7D/log", r.URL.EscapedPath())
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte("+ make test\nok\n"))
+	}))
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	log, _, logErr := client.Pipelines.GetStepLog(context.Background(), "owner", "repo", "{p-1}", "{s-1}")
+	assert.Nil(t, logErr)
+	assert.Equal(t, "+ make test\nok\n", log.String())
+}
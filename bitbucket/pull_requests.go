@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"time"
@@ -119,7 +120,7 @@ type Branch struct {
 // Example query string: source.repository.full_name != "main/repo" AND state = "OPEN" AND reviewers.username = "evzijst" AND destination.branch.name = "master"
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/pullrequests#get
-func (p *PullRequestsService) List(owner, repoSlug string, opts ...interface{}) (*PullRequests, *simpleresty.Response, error) {
+func (p *PullRequestsService) List(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*PullRequests, *simpleresty.Response, error) {
 	result := new(PullRequests)
 	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/pullrequests", owner, repoSlug), opts...)
@@ -127,7 +128,7 @@ func (p *PullRequestsService) List(owner, repoSlug string, opts ...interface{})
 		return nil, nil, urlStrErr
 	}
 
-	response, err := p.client.http.Get(urlStr, result, nil)
+	response, err := p.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -135,7 +136,7 @@ func (p *PullRequestsService) List(owner, repoSlug string, opts ...interface{})
 // Get returns a single pull request.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/pullrequests/%7Bpull_request_id%7D
-func (p *PullRequestsService) Get(owner, repoSlug string, pullRequestID int64, opts ...interface{}) (*PullRequest, *simpleresty.Response, error) {
+func (p *PullRequestsService) Get(ctx context.Context, owner, repoSlug string, pullRequestID int64, opts ...interface{}) (*PullRequest, *simpleresty.Response, error) {
 	result := new(PullRequest)
 	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/pullrequests/%v", owner, repoSlug, pullRequestID), opts...)
@@ -143,7 +144,7 @@ func (p *PullRequestsService) Get(owner, repoSlug string, pullRequestID int64, o
 		return nil, nil, urlStrErr
 	}
 
-	response, err := p.client.http.Get(urlStr, result, nil)
+	response, err := p.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -151,7 +152,7 @@ func (p *PullRequestsService) Get(owner, repoSlug string, pullRequestID int64, o
 // ListByUser returns all pull requests authored by the specified user.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/pullrequests/%7Btarget_user%7D#get
-func (p *PullRequestsService) ListByUser(targetUser string, opts ...interface{}) (*PullRequests, *simpleresty.Response, error) {
+func (p *PullRequestsService) ListByUser(ctx context.Context, targetUser string, opts ...interface{}) (*PullRequests, *simpleresty.Response, error) {
 	result := new(PullRequests)
 	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/pullrequests/%s", targetUser), opts...)
@@ -159,7 +160,7 @@ func (p *PullRequestsService) ListByUser(targetUser string, opts ...interface{})
 		return nil, nil, urlStrErr
 	}
 
-	response, err := p.client.http.Get(urlStr, result, nil)
+	response, err := p.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -169,10 +170,10 @@ func (p *PullRequestsService) ListByUser(targetUser string, opts ...interface{})
 // If the pull request's destination is not specified, it will default to the repository.mainbranch.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/pullrequests#post
-func (p *PullRequestsService) Create(owner, repoSlug string, po *PRRequest) (*PullRequest, *simpleresty.Response, error) {
+func (p *PullRequestsService) Create(ctx context.Context, owner, repoSlug string, po *PRRequest) (*PullRequest, *simpleresty.Response, error) {
 	result := new(PullRequest)
 	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pullrequests", owner, repoSlug)
-	response, err := p.client.http.Post(urlStr, result, po)
+	response, err := p.client.post(ctx, urlStr, result, po)
 
 	return result, response, err
 }
@@ -181,10 +182,10 @@ func (p *PullRequestsService) Create(owner, repoSlug string, po *PRRequest) (*Pu
 // This can be used to change the pull request's branches or description. Only open pull requests can be mutated.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/pullrequests/%7Bpull_request_id%7D#put
-func (p *PullRequestsService) Update(owner, repoSlug string, pullRequestID int64, po *PRRequest) (*PullRequest, *simpleresty.Response, error) {
+func (p *PullRequestsService) Update(ctx context.Context, owner, repoSlug string, pullRequestID int64, po *PRRequest) (*PullRequest, *simpleresty.Response, error) {
 	result := new(PullRequest)
 	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pullrequests/%v", owner, repoSlug, pullRequestID)
-	response, err := p.client.http.Put(urlStr, result, po)
+	response, err := p.client.put(ctx, urlStr, result, po)
 
 	return result, response, err
 }
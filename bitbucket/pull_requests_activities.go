@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"time"
@@ -10,8 +11,11 @@ const (
 	// UpdateActivity represents an update activity to a pull request.
 	UpdateActivity = "update"
 
-	// ApprovalActivity represents an update activity to a pull request.
+	// ApprovalActivity represents an approval activity to a pull request.
 	ApprovalActivity = "approval"
+
+	// ChangesRequestedActivity represents a request for changes to a pull request.
+	ChangesRequestedActivity = "changes_requested"
 )
 
 // PRActivities represents a collection of of pull request activity.
@@ -23,9 +27,10 @@ type PRActivities struct {
 
 // PRActivity represents a pull request activity.
 type PRActivity struct {
-	Update      *PRUpdateActivity   `json:"update,omitempty"`
-	Approval    *PRApprovalActivity `json:"approval,omitempty"`
-	PullRequest *PullRequest        `json:"pull_request,omitempty"`
+	Update           *PRUpdateActivity   `json:"update,omitempty"`
+	Approval         *PRApprovalActivity `json:"approval,omitempty"`
+	ChangesRequested *PRApprovalActivity `json:"changes_requested,omitempty"`
+	PullRequest      *PullRequest        `json:"pull_request,omitempty"`
 }
 
 // PRUpdateActivity represents a pull request update activity.
@@ -40,7 +45,7 @@ type PRUpdateActivity struct {
 	Date        *time.Time         `json:"date,omitempty"`
 }
 
-// PRApprovalActivity represents a pull request approval activity.
+// PRApprovalActivity represents a pull request approval or changes requested activity.
 type PRApprovalActivity struct {
 	Date        *time.Time   `json:"date,omitempty"`
 	PullRequest *PullRequest `json:"pull_request,omitempty"`
@@ -50,7 +55,7 @@ type PRApprovalActivity struct {
 // ListActivity returns a paginated list of all pull requests' activity log on a specified repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/pullrequests/activity#get
-func (p *PullRequestsService) ListActivity(owner, repoSlug string, opts ...interface{}) (*PRActivities, *simpleresty.Response, error) {
+func (p *PullRequestsService) ListActivity(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*PRActivities, *simpleresty.Response, error) {
 	result := new(PRActivities)
 	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/pullrequests/activity", owner, repoSlug), opts...)
@@ -58,7 +63,7 @@ func (p *PullRequestsService) ListActivity(owner, repoSlug string, opts ...inter
 		return nil, nil, urlStrErr
 	}
 
-	response, err := p.client.http.Get(urlStr, result, nil)
+	response, err := p.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -66,7 +71,7 @@ func (p *PullRequestsService) ListActivity(owner, repoSlug string, opts ...inter
 // GetActivity returns a paginated list of a single pull request's activity log in a repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/pullrequests/%7Bpull_request_id%7D/activity#get
-func (p *PullRequestsService) GetActivity(owner, repoSlug string, pullRequestID int64, opts ...interface{}) (*PRActivities, *simpleresty.Response, error) {
+func (p *PullRequestsService) GetActivity(ctx context.Context, owner, repoSlug string, pullRequestID int64, opts ...interface{}) (*PRActivities, *simpleresty.Response, error) {
 	result := new(PRActivities)
 	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/pullrequests/%v/activity", owner, repoSlug, pullRequestID), opts...)
@@ -74,20 +79,24 @@ func (p *PullRequestsService) GetActivity(owner, repoSlug string, pullRequestID
 		return nil, nil, urlStrErr
 	}
 
-	response, err := p.client.http.Get(urlStr, result, nil)
+	response, err := p.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
 
-// GetActivityType returns the non-nil field representing the activity: an update or approval.
+// GetActivityType returns the non-nil field representing the activity: an update, approval or changes request.
 // It returns the activity object and its type.
 func (p *PRActivity) GetActivityType() (interface{}, string) {
 	if v := p.GetUpdate(); v != nil {
-		return p.GetUpdate(), UpdateActivity
+		return v, UpdateActivity
 	}
 
 	if v := p.GetApproval(); v != nil {
-		return p.GetUpdate(), ApprovalActivity
+		return v, ApprovalActivity
+	}
+
+	if v := p.GetChangesRequested(); v != nil {
+		return v, ChangesRequestedActivity
 	}
 
 	return nil, ""
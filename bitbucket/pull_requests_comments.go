@@ -1,8 +1,11 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
+	"sort"
+	"time"
 )
 
 // PRComments represents a collection of a PR's comments.
@@ -16,13 +19,84 @@ type PRComments struct {
 type PRComment struct {
 	Comment
 
-	PullRequest *PullRequest `json:"pullrequest,omitempty"`
-	Deleted     *bool        `json:"deleted,omitempty"`
+	PullRequest *PullRequest         `json:"pullrequest,omitempty"`
+	Deleted     *bool                `json:"deleted,omitempty"`
+	Inline      *PRCommentInline     `json:"inline,omitempty"`
+	Parent      *Comment             `json:"parent,omitempty"`
+	Pending     *bool                `json:"pending,omitempty"`
+	Resolution  *PRCommentResolution `json:"resolution,omitempty"`
+}
+
+// PRCommentInline represents the file and lines an inline comment is anchored to.
+//
+// From is the line in the old version of the file and To the line in the new version.
+// Set only To to comment on an added line and only From to comment on a removed line.
+// StartFrom and StartTo mark the first line of a comment that spans several lines.
+type PRCommentInline struct {
+	Path      *string `json:"path,omitempty"`
+	From      *int64  `json:"from,omitempty"`
+	To        *int64  `json:"to,omitempty"`
+	StartFrom *int64  `json:"start_from,omitempty"`
+	StartTo   *int64  `json:"start_to,omitempty"`
+}
+
+// PRCommentResolution represents who resolved a comment thread and when.
+type PRCommentResolution struct {
+	Type      *string    `json:"type,omitempty"`
+	User      *User      `json:"user,omitempty"`
+	CreatedOn *time.Time `json:"created_on,omitempty"`
 }
 
 // PRCommentRequest represents a request to create or update a pull request comment.
+//
+// Set Inline to comment on a file and line, and Parent to a comment with its ID to reply to that comment.
+// Pending comments are only visible to their author until the review is published.
 type PRCommentRequest struct {
-	Content *Content `json:"content,omitempty"`
+	Content *Content         `json:"content,omitempty"`
+	Inline  *PRCommentInline `json:"inline,omitempty"`
+	Parent  *Comment         `json:"parent,omitempty"`
+	Pending *bool            `json:"pending,omitempty"`
+}
+
+// PRCommentThread represents a pull request comment and its replies.
+type PRCommentThread struct {
+	Comment *PRComment
+	Replies []*PRCommentThread
+}
+
+// IsResolved reports whether the thread has been resolved.
+func (t *PRCommentThread) IsResolved() bool {
+	return t.Comment.Resolution != nil
+}
+
+// NewPRCommentThreads arranges comments, such as all pages returned by ListComments, into threads.
+//
+// Threads and replies are ordered by comment ID, which is the order they were posted in.
+// A reply whose parent is not among comments starts a thread of its own.
+func NewPRCommentThreads(comments []*PRComment) []*PRCommentThread {
+	threads := make(map[int64]*PRCommentThread, len(comments))
+	sorted := make([]*PRComment, len(comments))
+	copy(sorted, comments)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetID() < sorted[j].GetID()
+	})
+
+	for _, c := range sorted {
+		threads[c.GetID()] = &PRCommentThread{Comment: c}
+	}
+
+	var roots []*PRCommentThread
+	for _, c := range sorted {
+		thread := threads[c.GetID()]
+		if parent, ok := threads[c.GetParent().GetID()]; c.Parent != nil && ok && parent != thread {
+			parent.Replies = append(parent.Replies, thread)
+			continue
+		}
+
+		roots = append(roots, thread)
+	}
+
+	return roots
 }
 
 // ListComments returns a paginated list of the pull request's comments.
@@ -30,7 +104,7 @@ type PRCommentRequest struct {
 // This includes both global, inline comments and replies.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/pullrequests/%7Bpull_request_id%7D/comments#get
-func (p *PullRequestsService) ListComments(owner, repoSlug string, pullRequestID int64, opts ...interface{}) (*PRComments, *simpleresty.Response, error) {
+func (p *PullRequestsService) ListComments(ctx context.Context, owner, repoSlug string, pullRequestID int64, opts ...interface{}) (*PRComments, *simpleresty.Response, error) {
 	result := new(PRComments)
 	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/pullrequests/%v/comments", owner, repoSlug, pullRequestID), opts...)
@@ -38,18 +112,33 @@ func (p *PullRequestsService) ListComments(owner, repoSlug string, pullRequestID
 		return nil, nil, urlStrErr
 	}
 
-	response, err := p.client.http.Get(urlStr, result, nil)
+	response, err := p.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
 
+// ListCommentThreads returns all of the pull request's comments arranged into threads.
+// It fetches every page of ListComments and passes the comments to NewPRCommentThreads.
+func (p *PullRequestsService) ListCommentThreads(ctx context.Context, owner, repoSlug string, pullRequestID int64, opts ...interface{}) ([]*PRCommentThread, error) {
+	pager := NewPager[*PRComment](p.client, func(ctx context.Context, opts ...interface{}) (*PRComments, *simpleresty.Response, error) {
+		return p.ListComments(ctx, owner, repoSlug, pullRequestID, opts...)
+	}, opts...)
+
+	comments, err := pager.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPRCommentThreads(comments), nil
+}
+
 // CreateComment creates a new pull request comment.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/pullrequests/%7Bpull_request_id%7D/comments#post
-func (p *PullRequestsService) CreateComment(owner, repoSlug string, pullRequestID int64, po *PRCommentRequest) (*PRComment, *simpleresty.Response, error) {
+func (p *PullRequestsService) CreateComment(ctx context.Context, owner, repoSlug string, pullRequestID int64, po *PRCommentRequest) (*PRComment, *simpleresty.Response, error) {
 	result := new(PRComment)
 	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pullrequests/%v/comments", owner, repoSlug, pullRequestID)
-	response, err := p.client.http.Post(urlStr, result, po)
+	response, err := p.client.post(ctx, urlStr, result, po)
 
 	return result, response, err
 }
@@ -57,7 +146,7 @@ func (p *PullRequestsService) CreateComment(owner, repoSlug string, pullRequestI
 // GetComment returns a specific pull request comment.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/pullrequests/%7Bpull_request_id%7D/comments/%7Bcomment_id%7D#get
-func (p *PullRequestsService) GetComment(owner, repoSlug string, prID, cID int64, opts ...interface{}) (*PRComment, *simpleresty.Response, error) {
+func (p *PullRequestsService) GetComment(ctx context.Context, owner, repoSlug string, prID, cID int64, opts ...interface{}) (*PRComment, *simpleresty.Response, error) {
 	result := new(PRComment)
 	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/pullrequests/%v/comments/%v", owner, repoSlug, prID, cID), opts...)
@@ -65,7 +154,7 @@ func (p *PullRequestsService) GetComment(owner, repoSlug string, prID, cID int64
 		return nil, nil, urlStrErr
 	}
 
-	response, err := p.client.http.Get(urlStr, result, nil)
+	response, err := p.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -73,10 +162,10 @@ func (p *PullRequestsService) GetComment(owner, repoSlug string, prID, cID int64
 // UpdateComment updates a specific pull request comment.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/pullrequests/%7Bpull_request_id%7D/comments#put
-func (p *PullRequestsService) UpdateComment(owner, repoSlug string, prID, cID int64, po *PRCommentRequest) (*PRComment, *simpleresty.Response, error) {
+func (p *PullRequestsService) UpdateComment(ctx context.Context, owner, repoSlug string, prID, cID int64, po *PRCommentRequest) (*PRComment, *simpleresty.Response, error) {
 	result := new(PRComment)
 	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pullrequests/%v/comments/%v", owner, repoSlug, prID, cID)
-	response, err := p.client.http.Put(urlStr, result, po)
+	response, err := p.client.put(ctx, urlStr, result, po)
 
 	return result, response, err
 }
@@ -84,9 +173,30 @@ func (p *PullRequestsService) UpdateComment(owner, repoSlug string, prID, cID in
 // DeleteComment updates a specific pull request comment.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/pullrequests/%7Bpull_request_id%7D/comments#delete
-func (p *PullRequestsService) DeleteComment(owner, repoSlug string, prID, cID int64) (*simpleresty.Response, error) {
+func (p *PullRequestsService) DeleteComment(ctx context.Context, owner, repoSlug string, prID, cID int64) (*simpleresty.Response, error) {
 	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pullrequests/%v/comments/%v", owner, repoSlug, prID, cID)
-	response, err := p.client.http.Delete(urlStr, nil, nil)
+	response, err := p.client.delete(ctx, urlStr, nil, nil)
+
+	return response, err
+}
+
+// ResolveComment resolves the thread started by a specific pull request comment.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-comments-comment-id-resolve-post
+func (p *PullRequestsService) ResolveComment(ctx context.Context, owner, repoSlug string, prID, cID int64) (*PRCommentResolution, *simpleresty.Response, error) {
+	result := new(PRCommentResolution)
+	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pullrequests/%v/comments/%v/resolve", owner, repoSlug, prID, cID)
+	response, err := p.client.post(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// UnresolveComment reopens the resolved thread started by a specific pull request comment.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-comments-comment-id-resolve-delete
+func (p *PullRequestsService) UnresolveComment(ctx context.Context, owner, repoSlug string, prID, cID int64) (*simpleresty.Response, error) {
+	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pullrequests/%v/comments/%v/resolve", owner, repoSlug, prID, cID)
+	response, err := p.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
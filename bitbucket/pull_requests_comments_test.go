@@ -0,0 +1,96 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPRCommentThreads(t *testing.T) {
+	comment := func(id, parent int64) *PRComment {
+		c := &PRComment{Comment: Comment{ID: &id}}
+		if parent != 0 {
+			c.Parent = &Comment{ID: &parent}
+		}
+		return c
+	}
+
+	threads := NewPRCommentThreads([]*PRComment{
+		comment(5, 2),
+		comment(1, 0),
+		comment(2, 1),
+		comment(3, 1),
+		comment(4, 0),
+		comment(6, 99),
+	})
+
+	assert.Len(t, threads, 3)
+	assert.Equal(t, int64(1), threads[0].Comment.GetID())
+	assert.Len(t, threads[0].Replies, 2)
+	assert.Equal(t, int64(2), threads[0].Replies[0].Comment.GetID())
+	assert.Equal(t, int64(5), threads[0].Replies[0].Replies[0].Comment.GetID())
+	assert.Equal(t, int64(3), threads[0].Replies[1].Comment.GetID())
+	assert.Equal(t, int64(4), threads[1].Comment.GetID())
+	assert.Equal(t, int64(6), threads[2].Comment.GetID())
+}
+
+func TestPullRequestsService_ListCommentThreads(t *testing.T) {
+	var serverURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`{"values":[{"id":2,"parent":{"id":1},"inline":{"path":"main.go","to":10}}]}`))
+			return
+		}
+
+		fmt.Fprintf(w, `{"values":[{"id":1,"resolution":{"type":"comment_resolution"}}],"next":"%s/repositories/owner/repo/pullrequests/1/comments?page=2"}`, serverURL)
+	}))
+	defer server.Close()
+	serverURL = server.URL
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	threads, listErr := client.PullRequests.ListCommentThreads(context.Background(), "owner", "repo", 1)
+	assert.Nil(t, listErr)
+	assert.Len(t, threads, 1)
+	assert.True(t, threads[0].IsResolved())
+	assert.Len(t, threads[0].Replies, 1)
+
+	reply := threads[0].Replies[0].Comment
+	assert.Equal(t, "main.go", reply.GetInline().GetPath())
+	assert.Equal(t, int64(10), reply.GetInline().GetTo())
+}
+
+func TestPullRequestsService_CreateInlineReply(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":3}`))
+	}))
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	raw, path, line, parent := "nit", "main.go", int64(10), int64(1)
+	_, _, createErr := client.PullRequests.CreateComment(context.Background(), "owner", "repo", 1, &PRCommentRequest{
+		Content: &Content{Raw: &raw},
+		Inline:  &PRCommentInline{Path: &path, To: &line},
+		Parent:  &Comment{ID: &parent},
+	})
+	assert.Nil(t, createErr)
+
+	assert.Equal(t, map[string]interface{}{
+		"content": map[string]interface{}{"raw": "nit"},
+		"inline":  map[string]interface{}{"path": "main.go", "to": float64(10)},
+		"parent":  map[string]interface{}{"id": float64(1)},
+	}, body)
+}
@@ -2,6 +2,7 @@ package bitbucket
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -9,29 +10,30 @@ import (
 // GetDiffRaw produces a raw, git-style diff for the pull requests
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/pullrequests/%7Bpull_request_id%7D/diff#get
-func (p *PullRequestsService) GetDiffRaw(owner, repoSlug string, pid int64) (*bytes.Buffer, *simpleresty.Response, error) {
+func (p *PullRequestsService) GetDiffRaw(ctx context.Context, owner, repoSlug string, pid int64) (*bytes.Buffer, *simpleresty.Response, error) {
 	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pullrequests/%v/diff", owner, repoSlug, pid)
 
-	var buff bytes.Buffer
-	req := p.client.http.NewRequest()
+	req := p.client.newRequest(ctx)
 	req.Method = simpleresty.GetMethod
 	req.URL = urlStr
-	req.Result = &buff
+	req.SetHeader("Accept", "text/plain")
 
-	response, reqErr := p.client.http.Dispatch(req)
+	response, reqErr := p.client.dispatch(req)
 	if reqErr != nil {
-		return nil, nil, reqErr
+		return nil, response, reqErr
 	}
 
-	return &buff, response, nil
+	return bytes.NewBuffer(response.Resp.Body()), response, nil
 }
 
 // GetDiff returns the diff stat for the specified pull request.
 //
 // Diff stat responses contain a record for every path modified by the commit and lists the number of lines added and removed for each file.
+// Use DiffStatOpts to ignore whitespace, disable rename detection or limit the diffstat to some paths.
+// Files in conflict between the source and destination branches are reported with a status for which Diff.IsConflict is true.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/pullrequests/%7Bpull_request_id%7D/diffstat#get
-func (p *PullRequestsService) GetDiff(owner, repoSlug string, pid int64, opts ...interface{}) (*Diffs, *simpleresty.Response, error) {
+func (p *PullRequestsService) GetDiff(ctx context.Context, owner, repoSlug string, pid int64, opts ...interface{}) (*Diffs, *simpleresty.Response, error) {
 	result := new(Diffs)
 	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/pullrequests/%v/diffstat", owner, repoSlug, pid), opts...)
@@ -39,7 +41,19 @@ func (p *PullRequestsService) GetDiff(owner, repoSlug string, pid int64, opts ..
 		return nil, nil, urlStrErr
 	}
 
-	response, err := p.client.http.Get(urlStr, result, nil)
+	response, err := p.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
+
+// GetDiffTotals returns the totals of the diff stat for the specified pull request, following every page.
+func (p *PullRequestsService) GetDiffTotals(ctx context.Context, owner, repoSlug string, pid int64, opts ...interface{}) (*DiffStatTotals, error) {
+	diffs, err := NewPager[*Diff](p.client, func(ctx context.Context, opts ...interface{}) (*Diffs, *simpleresty.Response, error) {
+		return p.GetDiff(ctx, owner, repoSlug, pid, opts...)
+	}, opts...).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDiffStatTotals(diffs), nil
+}
@@ -1,6 +1,46 @@
 package bitbucket
 
-import "github.com/davidji99/simpleresty"
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"net/http"
+	"time"
+)
+
+// DefaultMergePollInterval is the default interval at which MergePR polls the status of a merge task.
+const DefaultMergePollInterval = 2 * time.Second
+
+// MergeStrategy represents how the commits of a pull request are merged into its destination branch.
+type MergeStrategy string
+
+const (
+	// MergeStrategyMergeCommit merges the source branch with a merge commit.
+	MergeStrategyMergeCommit MergeStrategy = "merge_commit"
+
+	// MergeStrategySquash squashes the commits of the source branch into a single commit.
+	MergeStrategySquash MergeStrategy = "squash"
+
+	// MergeStrategyFastForward fast-forwards the destination branch, failing if that is not possible.
+	MergeStrategyFastForward MergeStrategy = "fast_forward"
+)
+
+const (
+	// MergeTaskStatusPending is the status of a merge task that has not finished yet.
+	MergeTaskStatusPending = "PENDING"
+
+	// MergeTaskStatusSuccess is the status of a merge task that merged the pull request.
+	MergeTaskStatusSuccess = "SUCCESS"
+)
+
+// MergeTaskError is returned by MergePR when a merge task ends with a status other than MergeTaskStatusSuccess.
+type MergeTaskError struct {
+	TaskStatus string
+}
+
+func (e *MergeTaskError) Error() string {
+	return fmt.Sprintf("bitbucket: merge task ended with status %q", e.TaskStatus)
+}
 
 // MergePrRequest represents a request to merge a pull request.
 type MergePrRequest struct {
@@ -14,18 +54,79 @@ type MergePrRequest struct {
 	// pull request was created, which defaults to False
 	CloseSourceBranch *bool `json:"close_source_branch,omitempty"`
 
-	// The merge strategy that will be used to merge the pull request. Default: merge_commit
-	// Valid values: merge_commit, squash, fast_forward
-	MergeStrategy string `json:"merge_strategy,omitempty"`
+	// The merge strategy that will be used to merge the pull request. Default: MergeStrategyMergeCommit
+	MergeStrategy MergeStrategy `json:"merge_strategy,omitempty"`
+}
+
+// PRMergeTaskStatus represents the status of a merge that Bitbucket runs in the background.
+type PRMergeTaskStatus struct {
+	TaskStatus  *string      `json:"task_status,omitempty"`
+	MergeResult *PullRequest `json:"merge_result,omitempty"`
 }
 
 // MergePR merges the pull request.
 //
+// Bitbucket answers merges that take a long time with a 202 and a link to a merge task. MergePR then
+// polls the task, every DefaultMergePollInterval unless changed with the MergePollInterval option,
+// while it is pending or until ctx is done, and returns the merged pull request. A task that ends with
+// any other status than MergeTaskStatusSuccess returns a *MergeTaskError.
+//
 // Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-merge-post
-func (p *PullRequestsService) MergePR(workspace, repoSlug string, pullRequestID int64, opts *MergePrRequest) (*PullRequest, *simpleresty.Response, error) {
+func (p *PullRequestsService) MergePR(ctx context.Context, workspace, repoSlug string, pullRequestID int64, opts *MergePrRequest) (*PullRequest, *simpleresty.Response, error) {
 	result := new(PullRequest)
 	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pullrequests/%v/merge", workspace, repoSlug, pullRequestID)
-	response, err := p.client.http.Post(urlStr, result, opts)
+	response, err := p.client.post(ctx, urlStr, result, opts)
+	if (err == nil || IsAccepted(err)) && response != nil && response.StatusCode == http.StatusAccepted {
+		return p.waitForMerge(ctx, response)
+	}
+
+	return result, response, err
+}
+
+// GetMergeTaskStatus returns the status of a merge task started by MergePR.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-merge-task-status-task-id-get
+func (p *PullRequestsService) GetMergeTaskStatus(ctx context.Context, workspace, repoSlug string, pullRequestID int64, taskID string) (*PRMergeTaskStatus, *simpleresty.Response, error) {
+	result := new(PRMergeTaskStatus)
+	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pullrequests/%v/merge/task-status/%s",
+		workspace, repoSlug, pullRequestID, taskID)
+	response, err := p.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
+
+// waitForMerge polls the merge task linked from the Location header of an accepted merge while it is pending.
+func (p *PullRequestsService) waitForMerge(ctx context.Context, accepted *simpleresty.Response) (*PullRequest, *simpleresty.Response, error) {
+	location := accepted.Resp.Header().Get("Location")
+	if location == "" {
+		return nil, accepted, fmt.Errorf("bitbucket: merge accepted without a task status link")
+	}
+
+	interval := p.client.mergePollInterval
+	if interval <= 0 {
+		interval = DefaultMergePollInterval
+	}
+
+	for {
+		status := new(PRMergeTaskStatus)
+		response, err := p.client.get(ctx, location, status, nil)
+		if err != nil {
+			return nil, response, err
+		}
+
+		switch status.GetTaskStatus() {
+		case MergeTaskStatusPending:
+		case MergeTaskStatusSuccess:
+			if status.MergeResult == nil {
+				return nil, response, fmt.Errorf("bitbucket: merge task succeeded without a merge result")
+			}
+			return status.MergeResult, response, nil
+		default:
+			return nil, response, &MergeTaskError{TaskStatus: status.GetTaskStatus()}
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return nil, response, err
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"regexp"
+	"strings"
+)
+
+const (
+	// MergeCheckState is reported when the pull request is not open.
+	MergeCheckState = "state"
+
+	// MergeCheckStrategy is reported when the destination branch does not allow the merge strategy.
+	MergeCheckStrategy = "merge_strategy"
+)
+
+// MergeBlocker represents a reason a pull request cannot be merged.
+type MergeBlocker struct {
+	// Check is MergeCheckState, MergeCheckStrategy or the kind of the branch restriction that is not met,
+	// such as BranchRestrictionRequireApprovals.
+	Check string
+
+	// Reason describes why the check fails.
+	Reason string
+}
+
+// MergeCheck represents the outcome of PullRequestsService.CheckMerge.
+type MergeCheck struct {
+	PullRequest *PullRequest
+	Blockers    []*MergeBlocker
+}
+
+// CanMerge reports whether no check blocks the merge.
+func (m *MergeCheck) CanMerge() bool {
+	return len(m.Blockers) == 0
+}
+
+// CheckMerge checks whether a pull request can be merged with strategy, and reports every reason it cannot.
+//
+// It checks that the pull request is open, that the destination branch allows strategy, and the branch
+// restrictions on the destination branch that require approvals, approvals from default reviewers, passing
+// builds, resolved tasks or no change requests. Leave strategy empty to skip the strategy check.
+//
+// Merge permissions and restrictions that match branches by branching model are not checked,
+// so MergePR may still be refused.
+func (p *PullRequestsService) CheckMerge(ctx context.Context, workspace, repoSlug string, pullRequestID int64, strategy MergeStrategy) (*MergeCheck, error) {
+	pr, _, err := p.Get(ctx, workspace, repoSlug, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	check := &MergeCheck{PullRequest: pr}
+	block := func(name, format string, args ...interface{}) {
+		check.Blockers = append(check.Blockers, &MergeBlocker{Check: name, Reason: fmt.Sprintf(format, args...)})
+	}
+
+	if pr.GetState() != "OPEN" {
+		block(MergeCheckState, "the pull request is %s", pr.GetState())
+	}
+
+	destination := pr.GetDestination().GetBranch().GetName()
+	if strategy != "" {
+		branch, _, err := p.client.Refs.GetBranch(ctx, workspace, repoSlug, destination)
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowsMergeStrategy(branch, strategy) {
+			block(MergeCheckStrategy, "%s does not allow the %s merge strategy", destination, strategy)
+		}
+	}
+
+	restrictions, err := NewPager[*BranchRestriction](p.client, func(ctx context.Context, opts ...interface{}) (*BranchRestrictions, *simpleresty.Response, error) {
+		return p.client.BranchRestrictions.List(ctx, workspace, repoSlug, opts...)
+	}).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The review state, builds and tasks are only fetched when a restriction needs them.
+	var summary *PRReviewSummary
+	var defaultReviewers []*User
+	reviews := func() error {
+		if summary != nil {
+			return nil
+		}
+
+		activities, err := NewPager[*PRActivity](p.client, func(ctx context.Context, opts ...interface{}) (*PRActivities, *simpleresty.Response, error) {
+			return p.GetActivity(ctx, workspace, repoSlug, pullRequestID, opts...)
+		}).All(ctx)
+		if err != nil {
+			return err
+		}
+
+		defaultReviewers, err = NewPager[*User](p.client, func(ctx context.Context, opts ...interface{}) (*Users, *simpleresty.Response, error) {
+			return p.client.DefaultReviewers.List(ctx, workspace, repoSlug, opts...)
+		}).All(ctx)
+		if err != nil {
+			return err
+		}
+
+		summary = NewPRReviewSummary(pr, activities, defaultReviewers)
+		return nil
+	}
+
+	for _, restriction := range restrictions {
+		if !restrictionMatches(restriction, destination) {
+			continue
+		}
+
+		required := restriction.GetValue()
+		switch restriction.GetKind() {
+		case BranchRestrictionRequireApprovals:
+			if err := reviews(); err != nil {
+				return nil, err
+			}
+
+			if !summary.MeetsApprovals(int(required)) {
+				block(restriction.GetKind(), "%d approvals required, %d given", required, len(summary.Approved))
+			}
+		case BranchRestrictionRequireDefaultReviewerApprovals:
+			if err := reviews(); err != nil {
+				return nil, err
+			}
+
+			if given := defaultReviewerApprovals(summary, defaultReviewers); given < required {
+				block(restriction.GetKind(), "%d approvals from default reviewers required, %d given", required, given)
+			}
+		case BranchRestrictionRequireNoChangesRequested:
+			if err := reviews(); err != nil {
+				return nil, err
+			}
+
+			if len(summary.ChangesRequested) > 0 {
+				block(restriction.GetKind(), "%d reviewers requested changes", len(summary.ChangesRequested))
+			}
+		case BranchRestrictionRequirePassingBuilds:
+			statuses, err := NewPager[*CommitStatus](p.client, func(ctx context.Context, opts ...interface{}) (*CommitStatuses, *simpleresty.Response, error) {
+				return p.ListStatuses(ctx, workspace, repoSlug, pullRequestID, opts...)
+			}).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			if reason := buildsBlock(statuses, required); reason != "" {
+				block(restriction.GetKind(), "%s", reason)
+			}
+		case BranchRestrictionRequireTasksCompleted:
+			tasks, err := NewPager[*PRTask](p.client, func(ctx context.Context, opts ...interface{}) (*PRTasks, *simpleresty.Response, error) {
+				return p.ListTasks(ctx, workspace, repoSlug, pullRequestID, opts...)
+			}).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			unresolved := 0
+			for _, task := range tasks {
+				if task.GetState() != PRTaskStateResolved {
+					unresolved++
+				}
+			}
+			if unresolved > 0 {
+				block(restriction.GetKind(), "%d tasks are unresolved", unresolved)
+			}
+		}
+	}
+
+	return check, nil
+}
+
+// allowsMergeStrategy reports whether branch allows strategy. A branch that lists no strategies allows all of them.
+func allowsMergeStrategy(branch *Ref, strategy MergeStrategy) bool {
+	if len(branch.MergeStrategies) == 0 {
+		return true
+	}
+
+	for _, s := range branch.MergeStrategies {
+		if s != nil && MergeStrategy(*s) == strategy {
+			return true
+		}
+	}
+
+	return false
+}
+
+// restrictionMatches reports whether a branch restriction applies to the branch name.
+// Only glob patterns are matched, where "*" matches any sequence of characters.
+func restrictionMatches(restriction *BranchRestriction, branch string) bool {
+	if kind := restriction.GetBranchMatchKind(); kind != "" && kind != "glob" {
+		return false
+	}
+
+	parts := strings.Split(restriction.GetPattern(), "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$").MatchString(branch)
+}
+
+// defaultReviewerApprovals counts the approvals given by default reviewers.
+func defaultReviewerApprovals(summary *PRReviewSummary, defaultReviewers []*User) int64 {
+	reviewers := make(map[string]bool, len(defaultReviewers))
+	for _, reviewer := range defaultReviewers {
+		reviewers[userKey(reviewer)] = true
+	}
+
+	var approvals int64
+	for _, review := range summary.Approved {
+		if reviewers[userKey(review.User)] {
+			approvals++
+		}
+	}
+
+	return approvals
+}
+
+// buildsBlock describes why the statuses do not satisfy a passing builds restriction, or returns an empty string.
+func buildsBlock(statuses []*CommitStatus, required int64) string {
+	var successful, failed, inProgress int64
+	for _, status := range statuses {
+		switch status.GetState() {
+		case CommitStatusSuccessful:
+			successful++
+		case CommitStatusFailed:
+			failed++
+		case CommitStatusInProgress:
+			inProgress++
+		}
+	}
+
+	switch {
+	case failed > 0:
+		return fmt.Sprintf("%d builds failed", failed)
+	case inProgress > 0:
+		return fmt.Sprintf("%d builds are in progress", inProgress)
+	case successful < required:
+		return fmt.Sprintf("%d successful builds required, %d passed", required, successful)
+	default:
+		return ""
+	}
+}
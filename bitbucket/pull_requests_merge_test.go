@@ -0,0 +1,156 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullRequestsService_MergePR_PollsMergeTask(t *testing.T) {
+	polls := 0
+	var body map[string]interface{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repositories/owner/repo/pullrequests/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Location", "http://"+r.Host+"/repositories/owner/repo/pullrequests/1/merge/task-status/t1")
+		w.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc("/repositories/owner/repo/pullrequests/1/merge/task-status/t1", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		w.Header().Set("Content-Type", "application/json")
+		if polls < 3 {
+			w.Write([]byte(`{"task_status":"PENDING"}`))
+			return
+		}
+		w.Write([]byte(`{"task_status":"SUCCESS","merge_result":{"id":1,"state":"MERGED"}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL), MergePollInterval(time.Millisecond))
+	assert.Nil(t, err)
+
+	pr, _, mergeErr := client.PullRequests.MergePR(context.Background(), "owner", "repo", 1,
+		&MergePrRequest{Type: "pullrequest", MergeStrategy: MergeStrategySquash})
+	assert.Nil(t, mergeErr)
+	assert.Equal(t, "MERGED", pr.GetState())
+	assert.Equal(t, 3, polls)
+	assert.Equal(t, "squash", body["merge_strategy"])
+}
+
+func TestPullRequestsService_MergePR_PollingStopsWithContext(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repositories/owner/repo/pullrequests/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "http://"+r.Host+"/repositories/owner/repo/pullrequests/1/merge/task-status/t1")
+		w.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc("/repositories/owner/repo/pullrequests/1/merge/task-status/t1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"task_status":"PENDING"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL), MergePollInterval(time.Millisecond))
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, _, mergeErr := client.PullRequests.MergePR(ctx, "owner", "repo", 1, &MergePrRequest{Type: "pullrequest"})
+	assert.ErrorIs(t, mergeErr, context.DeadlineExceeded)
+}
+
+func TestPullRequestsService_MergePR_MergeTaskFails(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		err      error
+	}{
+		{"failed", `{"task_status":"FAILED"}`, &MergeTaskError{TaskStatus: "FAILED"}},
+		{"missing status", `{}`, &MergeTaskError{}},
+		{"no merge result", `{"task_status":"SUCCESS"}`, fmt.Errorf("bitbucket: merge task succeeded without a merge result")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polls := 0
+			mux := http.NewServeMux()
+			mux.HandleFunc("/repositories/owner/repo/pullrequests/1/merge", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Location", "http://"+r.Host+"/repositories/owner/repo/pullrequests/1/merge/task-status/t1")
+				w.WriteHeader(http.StatusAccepted)
+			})
+			mux.HandleFunc("/repositories/owner/repo/pullrequests/1/merge/task-status/t1", func(w http.ResponseWriter, r *http.Request) {
+				polls++
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(tt.response))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, err := New("user", "password", BaseURL(server.URL), MergePollInterval(time.Hour))
+			assert.Nil(t, err)
+
+			// The error is returned after the first poll, without waiting for the interval or the context.
+			pr, _, mergeErr := client.PullRequests.MergePR(context.Background(), "owner", "repo", 1, &MergePrRequest{Type: "pullrequest"})
+			assert.Nil(t, pr)
+			assert.Equal(t, tt.err, mergeErr)
+			assert.Equal(t, 1, polls)
+		})
+	}
+}
+
+func TestPullRequestsService_CheckMerge(t *testing.T) {
+	mux := http.NewServeMux()
+	handle := func(path, body string) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, body)
+		})
+	}
+	handle("/repositories/owner/repo/pullrequests/1", `{"id":1,"state":"OPEN","author":{"uuid":"{author}"},
+		"destination":{"branch":{"name":"release/1.0"}},
+		"participants":[{"user":{"uuid":"{alice}"},"state":"approved"},{"user":{"uuid":"{bob}"},"state":"changes_requested"}]}`)
+	handle("/repositories/owner/repo/refs/branches/release/1.0", `{"name":"release/1.0","merge_strategies":["merge_commit","squash"]}`)
+	handle("/repositories/owner/repo/branch-restrictions", `{"values":[
+		{"kind":"require_approvals_to_merge","pattern":"release/*","branch_match_kind":"glob","value":2},
+		{"kind":"require_default_reviewer_approvals_to_merge","pattern":"release/*","branch_match_kind":"glob","value":1},
+		{"kind":"require_passing_builds_to_merge","pattern":"release/*","branch_match_kind":"glob","value":1},
+		{"kind":"require_tasks_to_be_completed","pattern":"release/*","branch_match_kind":"glob"},
+		{"kind":"require_no_changes_requested","pattern":"release/*","branch_match_kind":"glob"},
+		{"kind":"require_approvals_to_merge","pattern":"main","branch_match_kind":"glob","value":5},
+		{"kind":"require_approvals_to_merge","branch_match_kind":"branching_model","branch_type":"release","value":5}]}`)
+	handle("/repositories/owner/repo/pullrequests/1/activity", `{"values":[]}`)
+	handle("/repositories/owner/repo/default-reviewers", `{"values":[{"uuid":"{alice}"}]}`)
+	handle("/repositories/owner/repo/pullrequests/1/statuses", `{"values":[{"state":"SUCCESSFUL"},{"state":"INPROGRESS"}]}`)
+	handle("/repositories/owner/repo/pullrequests/1/tasks", `{"values":[{"id":1,"state":"RESOLVED"},{"id":2,"state":"UNRESOLVED"}]}`)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	check, checkErr := client.PullRequests.CheckMerge(context.Background(), "owner", "repo", 1, MergeStrategyFastForward)
+	assert.Nil(t, checkErr)
+	assert.False(t, check.CanMerge())
+
+	var checks []string
+	for _, blocker := range check.Blockers {
+		checks = append(checks, blocker.Check)
+	}
+	assert.Equal(t, []string{
+		MergeCheckStrategy,
+		BranchRestrictionRequireApprovals,
+		BranchRestrictionRequirePassingBuilds,
+		BranchRestrictionRequireTasksCompleted,
+		BranchRestrictionRequireNoChangesRequested,
+	}, checks)
+	assert.Equal(t, "2 approvals required, 1 given", check.Blockers[1].Reason)
+	assert.Equal(t, "1 builds are in progress", check.Blockers[2].Reason)
+}
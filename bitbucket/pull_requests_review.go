@@ -0,0 +1,167 @@
+package bitbucket
+
+import (
+	"context"
+	"github.com/davidji99/simpleresty"
+	"time"
+)
+
+const (
+	// ParticipantRoleReviewer is the role of a participant who was added as a reviewer.
+	ParticipantRoleReviewer = "REVIEWER"
+
+	// ParticipantRoleParticipant is the role of a participant who interacted with a pull request without being a reviewer.
+	ParticipantRoleParticipant = "PARTICIPANT"
+
+	// ParticipantStateApproved is the state of a participant who approved a pull request.
+	ParticipantStateApproved = "approved"
+
+	// ParticipantStateChangesRequested is the state of a participant who requested changes to a pull request.
+	ParticipantStateChangesRequested = "changes_requested"
+)
+
+// PRReview represents the review of a single participant.
+type PRReview struct {
+	User  *User
+	State string
+	Date  *time.Time
+}
+
+// PRReviewSummary represents the review state of a pull request.
+type PRReviewSummary struct {
+	// Approved holds the participants who currently approve the pull request.
+	Approved []*PRReview
+
+	// ChangesRequested holds the participants who currently request changes.
+	ChangesRequested []*PRReview
+
+	// MissingDefaultReviewers holds the default reviewers, other than the author, who have not approved the pull request.
+	MissingDefaultReviewers []*User
+}
+
+// MeetsApprovals reports whether at least n participants approve the pull request.
+// Change requests do not count against it, check ChangesRequested for those.
+func (s *PRReviewSummary) MeetsApprovals(n int) bool {
+	return len(s.Approved) >= n
+}
+
+// NewPRReviewSummary summarizes the review state of pr.
+//
+// The current state of each reviewer is read from the participants of pr. Since the participants
+// are missing from pull requests returned by List, the approvals and change requests in activities,
+// the activity log of pr, are used instead when there are none. The activity log also provides the
+// date of each review. defaultReviewers, such as the users returned by DefaultReviewersService.List,
+// are compared against the approvals.
+func NewPRReviewSummary(pr *PullRequest, activities []*PRActivity, defaultReviewers []*User) *PRReviewSummary {
+	// latest holds the most recent review of each user found in the activity log.
+	latest := make(map[string]*PRReview)
+	var order []string
+	for _, activity := range activities {
+		review := reviewFromActivity(activity)
+		if review == nil {
+			continue
+		}
+
+		key := userKey(review.User)
+		current, ok := latest[key]
+		if !ok {
+			order = append(order, key)
+		}
+		if !ok || review.Date != nil && (current.Date == nil || review.Date.After(*current.Date)) {
+			latest[key] = review
+		}
+	}
+
+	var reviews []*PRReview
+	if len(pr.Participants) > 0 {
+		for _, participant := range pr.Participants {
+			state := participant.GetState()
+			if state == "" && participant.GetApproved() {
+				state = ParticipantStateApproved
+			}
+			if state == "" {
+				continue
+			}
+
+			review := &PRReview{User: participant.User, State: state, Date: participant.ParticipatedOn}
+			if activity, ok := latest[userKey(participant.User)]; ok && activity.State == state && activity.Date != nil {
+				review.Date = activity.Date
+			}
+			reviews = append(reviews, review)
+		}
+	} else {
+		for _, key := range order {
+			reviews = append(reviews, latest[key])
+		}
+	}
+
+	summary := new(PRReviewSummary)
+	approved := make(map[string]bool)
+	for _, review := range reviews {
+		switch review.State {
+		case ParticipantStateApproved:
+			summary.Approved = append(summary.Approved, review)
+			approved[userKey(review.User)] = true
+		case ParticipantStateChangesRequested:
+			summary.ChangesRequested = append(summary.ChangesRequested, review)
+		}
+	}
+
+	for _, reviewer := range defaultReviewers {
+		// Authors cannot review their own pull requests.
+		if !approved[userKey(reviewer)] && userKey(reviewer) != userKey(pr.Author) {
+			summary.MissingDefaultReviewers = append(summary.MissingDefaultReviewers, reviewer)
+		}
+	}
+
+	return summary
+}
+
+// GetReviewSummary returns the review state of a pull request. It fetches the pull request, all pages of its
+// activity log and the repository's default reviewers, and passes them to NewPRReviewSummary.
+func (p *PullRequestsService) GetReviewSummary(ctx context.Context, owner, repoSlug string, pullRequestID int64) (*PRReviewSummary, error) {
+	pr, _, err := p.Get(ctx, owner, repoSlug, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	activities, err := NewPager[*PRActivity](p.client, func(ctx context.Context, opts ...interface{}) (*PRActivities, *simpleresty.Response, error) {
+		return p.GetActivity(ctx, owner, repoSlug, pullRequestID, opts...)
+	}).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultReviewers, err := NewPager[*User](p.client, func(ctx context.Context, opts ...interface{}) (*Users, *simpleresty.Response, error) {
+		return p.client.DefaultReviewers.List(ctx, owner, repoSlug, opts...)
+	}).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPRReviewSummary(pr, activities, defaultReviewers), nil
+}
+
+// reviewFromActivity returns the review recorded by an approval or changes requested activity, or nil for other activities.
+func reviewFromActivity(activity *PRActivity) *PRReview {
+	switch {
+	case activity.Approval != nil:
+		return &PRReview{User: activity.Approval.User, State: ParticipantStateApproved, Date: activity.Approval.Date}
+	case activity.ChangesRequested != nil:
+		return &PRReview{User: activity.ChangesRequested.User, State: ParticipantStateChangesRequested, Date: activity.ChangesRequested.Date}
+	default:
+		return nil
+	}
+}
+
+// userKey identifies a user by UUID, falling back to the account ID and username.
+func userKey(u *User) string {
+	switch {
+	case u.GetUUID() != "":
+		return u.GetUUID()
+	case u.GetAccountID() != "":
+		return u.GetAccountID()
+	default:
+		return u.GetUsername()
+	}
+}
@@ -0,0 +1,72 @@
+package bitbucket
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testUser(uuid string) *User {
+	return &User{UUID: &uuid}
+}
+
+func TestNewPRReviewSummary_Participants(t *testing.T) {
+	approved, changesRequested, role := ParticipantStateApproved, ParticipantStateChangesRequested, ParticipantRoleReviewer
+	yes := true
+	participatedOn := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	approvedOn := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	pr := &PullRequest{
+		Author: testUser("{author}"),
+		Participants: []*Participant{
+			{User: testUser("{alice}"), Role: &role, State: &approved, ParticipatedOn: &participatedOn},
+			{User: testUser("{bob}"), Role: &role, State: &changesRequested},
+			{User: testUser("{carol}"), Approved: &yes},
+			{User: testUser("{dave}"), Role: &role},
+		},
+	}
+	activities := []*PRActivity{
+		{Approval: &PRApprovalActivity{User: testUser("{alice}"), Date: &approvedOn}},
+		{Update: &PRUpdateActivity{}},
+	}
+	defaultReviewers := []*User{testUser("{alice}"), testUser("{dave}"), testUser("{author}")}
+
+	summary := NewPRReviewSummary(pr, activities, defaultReviewers)
+
+	assert.Len(t, summary.Approved, 2)
+	assert.Equal(t, "{alice}", summary.Approved[0].User.GetUUID())
+	assert.Equal(t, approvedOn, *summary.Approved[0].Date)
+	assert.Equal(t, "{carol}", summary.Approved[1].User.GetUUID())
+
+	assert.Len(t, summary.ChangesRequested, 1)
+	assert.Equal(t, "{bob}", summary.ChangesRequested[0].User.GetUUID())
+
+	assert.Len(t, summary.MissingDefaultReviewers, 1)
+	assert.Equal(t, "{dave}", summary.MissingDefaultReviewers[0].GetUUID())
+
+	assert.True(t, summary.MeetsApprovals(2))
+	assert.False(t, summary.MeetsApprovals(3))
+}
+
+func TestNewPRReviewSummary_ActivityLog(t *testing.T) {
+	day := func(d int) *time.Time {
+		date := time.Date(2022, 1, d, 0, 0, 0, 0, time.UTC)
+		return &date
+	}
+
+	// The activity log lists the newest activity first.
+	activities := []*PRActivity{
+		{ChangesRequested: &PRApprovalActivity{User: testUser("{alice}"), Date: day(3)}},
+		{Approval: &PRApprovalActivity{User: testUser("{bob}"), Date: day(2)}},
+		{Approval: &PRApprovalActivity{User: testUser("{alice}"), Date: day(1)}},
+	}
+
+	summary := NewPRReviewSummary(&PullRequest{}, activities, nil)
+
+	assert.Len(t, summary.Approved, 1)
+	assert.Equal(t, "{bob}", summary.Approved[0].User.GetUUID())
+	assert.Len(t, summary.ChangesRequested, 1)
+	assert.Equal(t, "{alice}", summary.ChangesRequested[0].User.GetUUID())
+	assert.True(t, summary.MeetsApprovals(1))
+}
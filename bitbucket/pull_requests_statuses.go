@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -8,7 +9,7 @@ import (
 // ListStatuses returns all statuses (e.g. build results) for the given pull request.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/pullrequests/%7Bpull_request_id%7D/statuses#get
-func (p *PullRequestsService) ListStatuses(owner, repoSlug string, pid int64, opts ...interface{}) (*CommitStatuses, *simpleresty.Response, error) {
+func (p *PullRequestsService) ListStatuses(ctx context.Context, owner, repoSlug string, pid int64, opts ...interface{}) (*CommitStatuses, *simpleresty.Response, error) {
 	result := new(CommitStatuses)
 	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/pullrequests/%v/statuses", owner, repoSlug, pid), opts...)
@@ -16,7 +17,7 @@ func (p *PullRequestsService) ListStatuses(owner, repoSlug string, pid int64, op
 		return nil, nil, urlStrErr
 	}
 
-	response, err := p.client.http.Get(urlStr, result, nil)
+	response, err := p.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -0,0 +1,137 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"time"
+)
+
+const (
+	// PRTaskStateResolved is the state of a resolved pull request task.
+	PRTaskStateResolved = "RESOLVED"
+
+	// PRTaskStateUnresolved is the state of an open pull request task.
+	PRTaskStateUnresolved = "UNRESOLVED"
+)
+
+// PRTasks represents a collection of a PR's tasks.
+type PRTasks struct {
+	PaginationInfo
+
+	Values []*PRTask `json:"values,omitempty"`
+}
+
+// PRTask represents a pull request task, optionally tied to a comment.
+type PRTask struct {
+	ID         *int64       `json:"id,omitempty"`
+	State      *string      `json:"state,omitempty"`
+	Content    *Content     `json:"content,omitempty"`
+	Creator    *User        `json:"creator,omitempty"`
+	Pending    *bool        `json:"pending,omitempty"`
+	Comment    *PRComment   `json:"comment,omitempty"`
+	CreatedOn  *time.Time   `json:"created_on,omitempty"`
+	UpdatedOn  *time.Time   `json:"updated_on,omitempty"`
+	ResolvedOn *time.Time   `json:"resolved_on,omitempty"`
+	ResolvedBy *User        `json:"resolved_by,omitempty"`
+	Links      *PRTaskLinks `json:"links,omitempty"`
+}
+
+// PRTaskLinks represents the "links" object in a pull request task.
+type PRTaskLinks struct {
+	Self *Link `json:"self,omitempty"`
+	HTML *Link `json:"html,omitempty"`
+}
+
+// PRTaskRequest represents a request to create or update a pull request task.
+//
+// To tie a new task to a comment, set Comment to a comment with its ID.
+// When updating, set State to PRTaskStateResolved or PRTaskStateUnresolved.
+type PRTaskRequest struct {
+	Content *Content `json:"content,omitempty"`
+	Comment *Comment `json:"comment,omitempty"`
+	Pending *bool    `json:"pending,omitempty"`
+	State   *string  `json:"state,omitempty"`
+}
+
+// ListTasks returns a paginated list of the pull request's tasks.
+//
+// Filter on the task state with FilterSortOpts, e.g. a Query of `state = "UNRESOLVED"` returns the open tasks.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-tasks-get
+func (p *PullRequestsService) ListTasks(ctx context.Context, owner, repoSlug string, pullRequestID int64, opts ...interface{}) (*PRTasks, *simpleresty.Response, error) {
+	result := new(PRTasks)
+	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pullrequests/%v/tasks", owner, repoSlug, pullRequestID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := p.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// CreateTask creates a new pull request task.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-tasks-post
+func (p *PullRequestsService) CreateTask(ctx context.Context, owner, repoSlug string, pullRequestID int64, po *PRTaskRequest) (*PRTask, *simpleresty.Response, error) {
+	result := new(PRTask)
+	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pullrequests/%v/tasks", owner, repoSlug, pullRequestID)
+	response, err := p.client.post(ctx, urlStr, result, po)
+
+	return result, response, err
+}
+
+// GetTask returns a specific pull request task.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-tasks-task-id-get
+func (p *PullRequestsService) GetTask(ctx context.Context, owner, repoSlug string, prID, taskID int64, opts ...interface{}) (*PRTask, *simpleresty.Response, error) {
+	result := new(PRTask)
+	urlStr, urlStrErr := p.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/pullrequests/%v/tasks/%v", owner, repoSlug, prID, taskID), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := p.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// UpdateTask updates the content or state of a specific pull request task.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-tasks-task-id-put
+func (p *PullRequestsService) UpdateTask(ctx context.Context, owner, repoSlug string, prID, taskID int64, po *PRTaskRequest) (*PRTask, *simpleresty.Response, error) {
+	result := new(PRTask)
+	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pullrequests/%v/tasks/%v", owner, repoSlug, prID, taskID)
+	response, err := p.client.put(ctx, urlStr, result, po)
+
+	return result, response, err
+}
+
+// ResolveTask marks a specific pull request task as resolved.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-tasks-task-id-put
+func (p *PullRequestsService) ResolveTask(ctx context.Context, owner, repoSlug string, prID, taskID int64) (*PRTask, *simpleresty.Response, error) {
+	state := PRTaskStateResolved
+	return p.UpdateTask(ctx, owner, repoSlug, prID, taskID, &PRTaskRequest{State: &state})
+}
+
+// ReopenTask marks a specific pull request task as unresolved.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-tasks-task-id-put
+func (p *PullRequestsService) ReopenTask(ctx context.Context, owner, repoSlug string, prID, taskID int64) (*PRTask, *simpleresty.Response, error) {
+	state := PRTaskStateUnresolved
+	return p.UpdateTask(ctx, owner, repoSlug, prID, taskID, &PRTaskRequest{State: &state})
+}
+
+// DeleteTask deletes a specific pull request task.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-tasks-task-id-delete
+func (p *PullRequestsService) DeleteTask(ctx context.Context, owner, repoSlug string, prID, taskID int64) (*simpleresty.Response, error) {
+	urlStr := p.client.http.RequestURL("/repositories/%s/%s/pullrequests/%v/tasks/%v", owner, repoSlug, prID, taskID)
+	response, err := p.client.delete(ctx, urlStr, nil, nil)
+
+	return response, err
+}
@@ -0,0 +1,62 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullRequestsService_CreateTask(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/repositories/owner/repo/pullrequests/1/tasks", r.URL.Path)
+		json.NewDecoder(r.Body).Decode(&body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":7,"state":"UNRESOLVED","content":{"raw":"fix it"},"comment":{"id":3}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	raw, commentID := "fix it", int64(3)
+	task, _, createErr := client.PullRequests.CreateTask(context.Background(), "owner", "repo", 1,
+		&PRTaskRequest{Content: &Content{Raw: &raw}, Comment: &Comment{ID: &commentID}})
+	assert.Nil(t, createErr)
+	assert.Equal(t, int64(7), task.GetID())
+	assert.Equal(t, PRTaskStateUnresolved, task.GetState())
+	assert.Equal(t, int64(3), task.GetComment().GetID())
+
+	assert.Equal(t, map[string]interface{}{
+		"content": map[string]interface{}{"raw": "fix it"},
+		"comment": map[string]interface{}{"id": float64(3)},
+	}, body)
+}
+
+func TestPullRequestsService_ResolveTask(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/repositories/owner/repo/pullrequests/1/tasks/7", r.URL.Path)
+		json.NewDecoder(r.Body).Decode(&body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":7,"state":"RESOLVED"}`))
+	}))
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	task, _, resolveErr := client.PullRequests.ResolveTask(context.Background(), "owner", "repo", 1, 7)
+	assert.Nil(t, resolveErr)
+	assert.Equal(t, PRTaskStateResolved, task.GetState())
+	assert.Equal(t, map[string]interface{}{"state": "RESOLVED"}, body)
+}
@@ -0,0 +1,107 @@
+package bitbucket
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+	headerRateLimitResource  = "X-RateLimit-Resource"
+	headerRateLimitNearLimit = "X-RateLimit-NearLimit"
+)
+
+// RateLimit represents the rate limit state reported by Bitbucket on the most recent response.
+//
+// Bitbucket API docs: https://support.atlassian.com/bitbucket-cloud/docs/api-request-limits/
+type RateLimit struct {
+	// Limit is the number of requests permitted in the current window, or -1 if it was not reported.
+	Limit int
+
+	// Remaining is the number of requests left in the current window, or -1 if it was not reported.
+	Remaining int
+
+	// Reset is when the current window resets. It is the zero time if it was not reported.
+	Reset time.Time
+
+	// Resource is the rate limited resource the response counted against.
+	Resource string
+
+	// NearLimit is true when Bitbucket reports that less than 20% of the window is left.
+	NearLimit bool
+
+	// UpdatedAt is when the state was last updated. It is the zero time if no response carried rate limit headers.
+	UpdatedAt time.Time
+}
+
+// RateLimit returns the last-seen rate limit state, so callers can throttle before Bitbucket rejects requests.
+func (c *Client) RateLimit() RateLimit {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+
+	return c.rateLimit
+}
+
+// updateRateLimit records the rate limit headers of a response, if there are any.
+func (c *Client) updateRateLimit(h http.Header) {
+	rl, ok := parseRateLimit(h, time.Now())
+	if !ok {
+		return
+	}
+
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+
+	c.rateLimit = rl
+}
+
+// parseRateLimit reads the X-RateLimit headers. It returns false if none of them are present.
+func parseRateLimit(h http.Header, now time.Time) (RateLimit, bool) {
+	rl := RateLimit{Limit: -1, Remaining: -1}
+	found := false
+
+	if v, err := strconv.Atoi(h.Get(headerRateLimitLimit)); err == nil {
+		rl.Limit = v
+		found = true
+	}
+
+	if v, err := strconv.Atoi(h.Get(headerRateLimitRemaining)); err == nil {
+		rl.Remaining = v
+		found = true
+	}
+
+	if reset, ok := parseRateLimitReset(h.Get(headerRateLimitReset)); ok {
+		rl.Reset = reset
+		found = true
+	}
+
+	if v := h.Get(headerRateLimitResource); v != "" {
+		rl.Resource = v
+		found = true
+	}
+
+	if v := h.Get(headerRateLimitNearLimit); v != "" {
+		rl.NearLimit = strings.EqualFold(v, "true")
+		found = true
+	}
+
+	if found {
+		rl.UpdatedAt = now
+	}
+
+	return rl, found
+}
+
+// parseRateLimitReset parses the X-RateLimit-Reset header, which holds a Unix timestamp in seconds.
+func parseRateLimitReset(v string) (time.Time, bool) {
+	secs, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || secs <= 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(secs, 0), true
+}
@@ -0,0 +1,48 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/davidji99/simpleresty"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRawMethods_ReturnBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "text/plain", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("raw " + r.URL.Path))
+	}))
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	tests := map[string]func() (*bytes.Buffer, *simpleresty.Response, error){
+		"/repositories/owner/repo/diff/abc": func() (*bytes.Buffer, *simpleresty.Response, error) {
+			return client.Diff.GetRaw(ctx, "owner", "repo", "abc")
+		},
+		"/repositories/owner/repo/patch/abc": func() (*bytes.Buffer, *simpleresty.Response, error) {
+			return client.Patch.GetRaw(ctx, "owner", "repo", "abc")
+		},
+		"/repositories/owner/repo/pullrequests/1/diff": func() (*bytes.Buffer, *simpleresty.Response, error) {
+			return client.PullRequests.GetDiffRaw(ctx, "owner", "repo", 1)
+		},
+		"/repositories/owner/repo/pullrequests/1/patch": func() (*bytes.Buffer, *simpleresty.Response, error) {
+			return client.PullRequests.GetPatchRaw(ctx, "owner", "repo", 1)
+		},
+	}
+
+	for path, get := range tests {
+		t.Run(path, func(t *testing.T) {
+			buff, _, getErr := get()
+			assert.Nil(t, getErr)
+			assert.Equal(t, "raw "+path, buff.String())
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"time"
@@ -46,7 +47,7 @@ type RefLinks struct {
 // Note that this follows simple lexical ordering of the ref names.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/refs#get
-func (r *RefsService) ListAll(owner, repoSlug string, opts ...interface{}) (*Refs, *simpleresty.Response, error) {
+func (r *RefsService) ListAll(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Refs, *simpleresty.Response, error) {
 	result := new(Refs)
 	urlStr, urlStrErr := r.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/refs", owner, repoSlug), opts...)
@@ -54,7 +55,7 @@ func (r *RefsService) ListAll(owner, repoSlug string, opts ...interface{}) (*Ref
 		return nil, nil, urlStrErr
 	}
 
-	response, err := r.client.http.Get(urlStr, result, nil)
+	response, err := r.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
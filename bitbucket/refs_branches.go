@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -17,7 +18,7 @@ type RefRequest struct {
 // Results will be in the order the source control manager returns them.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/refs/branches#get
-func (r *RefsService) ListBranches(owner, repoSlug string, opts ...interface{}) (*Refs, *simpleresty.Response, error) {
+func (r *RefsService) ListBranches(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Refs, *simpleresty.Response, error) {
 	result := new(Refs)
 	urlStr, urlStrErr := r.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/refs/branches", owner, repoSlug), opts...)
@@ -25,7 +26,7 @@ func (r *RefsService) ListBranches(owner, repoSlug string, opts ...interface{})
 		return nil, nil, urlStrErr
 	}
 
-	response, err := r.client.http.Get(urlStr, result, nil)
+	response, err := r.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -35,11 +36,11 @@ func (r *RefsService) ListBranches(owner, repoSlug string, opts ...interface{})
 // The branch name should not include any prefixes (e.g. refs/heads).
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/refs/branches#post
-func (r *RefsService) CreateBranch(owner, repoSlug string, ro *RefRequest) (*Ref, *simpleresty.Response, error) {
+func (r *RefsService) CreateBranch(ctx context.Context, owner, repoSlug string, ro *RefRequest) (*Ref, *simpleresty.Response, error) {
 	result := new(Ref)
 	urlStr := r.client.http.RequestURL("/repositories/%s/%s/refs/branches", owner, repoSlug)
 
-	response, err := r.client.http.Post(urlStr, result, ro)
+	response, err := r.client.post(ctx, urlStr, result, ro)
 
 	return result, response, err
 }
@@ -47,7 +48,7 @@ func (r *RefsService) CreateBranch(owner, repoSlug string, ro *RefRequest) (*Ref
 // GetBranch returns a branch object within the specified repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/refs/branches/%7Bname%7D#get
-func (r *RefsService) GetBranch(owner, repoSlug, name string, opts ...interface{}) (*Ref, *simpleresty.Response, error) {
+func (r *RefsService) GetBranch(ctx context.Context, owner, repoSlug, name string, opts ...interface{}) (*Ref, *simpleresty.Response, error) {
 	result := new(Ref)
 	urlStr, urlStrErr := r.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/refs/branches/%s", owner, repoSlug, name), opts...)
@@ -55,7 +56,7 @@ func (r *RefsService) GetBranch(owner, repoSlug, name string, opts ...interface{
 		return nil, nil, urlStrErr
 	}
 
-	response, err := r.client.http.Get(urlStr, result, nil)
+	response, err := r.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -65,9 +66,9 @@ func (r *RefsService) GetBranch(owner, repoSlug, name string, opts ...interface{
 // The main branch is not allowed to be deleted and will return a 400 response.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/refs/branches/%7Bname%7D#delete
-func (r *RefsService) DeleteBranch(owner, repoSlug, name string) (*simpleresty.Response, error) {
+func (r *RefsService) DeleteBranch(ctx context.Context, owner, repoSlug, name string) (*simpleresty.Response, error) {
 	urlStr := r.client.http.RequestURL("/repositories/%s/%s/refs/branches/%s", owner, repoSlug, name)
-	response, err := r.client.http.Delete(urlStr, nil, nil)
+	response, err := r.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
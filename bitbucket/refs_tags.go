@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -9,7 +10,7 @@ import (
 // Results will be in the order the source control manager returns them.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/refs/tags#get
-func (r *RefsService) ListTags(owner, repoSlug string, opts ...interface{}) (*Refs, *simpleresty.Response, error) {
+func (r *RefsService) ListTags(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Refs, *simpleresty.Response, error) {
 	result := new(Refs)
 	urlStr, urlStrErr := r.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/refs/tags", owner, repoSlug), opts...)
@@ -17,7 +18,7 @@ func (r *RefsService) ListTags(owner, repoSlug string, opts ...interface{}) (*Re
 		return nil, nil, urlStrErr
 	}
 
-	response, err := r.client.http.Get(urlStr, result, nil)
+	response, err := r.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -25,10 +26,10 @@ func (r *RefsService) ListTags(owner, repoSlug string, opts ...interface{}) (*Re
 // CreateTag creates a new tag in the specified repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/refs/tags#post
-func (r *RefsService) CreateTag(owner, repoSlug string, ro *RefRequest) (*Ref, *simpleresty.Response, error) {
+func (r *RefsService) CreateTag(ctx context.Context, owner, repoSlug string, ro *RefRequest) (*Ref, *simpleresty.Response, error) {
 	result := new(Ref)
 	urlStr := r.client.http.RequestURL("/repositories/%s/%s/refs/tags", owner, repoSlug)
-	response, err := r.client.http.Post(urlStr, result, ro)
+	response, err := r.client.post(ctx, urlStr, result, ro)
 
 	return result, response, err
 }
@@ -36,7 +37,7 @@ func (r *RefsService) CreateTag(owner, repoSlug string, ro *RefRequest) (*Ref, *
 // GetTag returns a tag object within the specified repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/refs/tags/%7Bname%7D#get
-func (r *RefsService) GetTag(owner, repoSlug, name string, opts ...interface{}) (*Ref, *simpleresty.Response, error) {
+func (r *RefsService) GetTag(ctx context.Context, owner, repoSlug, name string, opts ...interface{}) (*Ref, *simpleresty.Response, error) {
 	result := new(Ref)
 	urlStr, urlStrErr := r.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/refs/tags/%s", owner, repoSlug, name), opts...)
@@ -44,7 +45,7 @@ func (r *RefsService) GetTag(owner, repoSlug, name string, opts ...interface{})
 		return nil, nil, urlStrErr
 	}
 
-	response, err := r.client.http.Get(urlStr, result, nil)
+	response, err := r.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -52,9 +53,9 @@ func (r *RefsService) GetTag(owner, repoSlug, name string, opts ...interface{})
 // DeleteTag deletes a tag in the specified repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/refs/tags/%7Bname%7D#delete
-func (r *RefsService) DeleteTag(owner, repoSlug, name string) (*simpleresty.Response, error) {
+func (r *RefsService) DeleteTag(ctx context.Context, owner, repoSlug, name string) (*simpleresty.Response, error) {
 	urlStr := r.client.http.RequestURL("/repositories/%s/%s/refs/tags/%s", owner, repoSlug, name)
-	response, err := r.client.http.Delete(urlStr, nil, nil)
+	response, err := r.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
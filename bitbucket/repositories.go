@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -133,7 +134,7 @@ type RepositoryDeleteQueryParam struct {
 // ListPublic returns all public repositories.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories#get
-func (r *RepositoriesService) ListPublic(opts ...interface{}) (*Repositories, *simpleresty.Response, error) {
+func (r *RepositoriesService) ListPublic(ctx context.Context, opts ...interface{}) (*Repositories, *simpleresty.Response, error) {
 	result := new(Repositories)
 	urlStr, urlStrErr := r.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories"), opts...)
@@ -141,7 +142,7 @@ func (r *RepositoriesService) ListPublic(opts ...interface{}) (*Repositories, *s
 		return nil, nil, urlStrErr
 	}
 
-	response, err := r.client.http.Get(urlStr, result, nil)
+	response, err := r.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -151,7 +152,7 @@ func (r *RepositoriesService) ListPublic(opts ...interface{}) (*Repositories, *s
 // Accepts a query parameter for 'role.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D#get
-func (r *RepositoriesService) List(owner string, opts ...interface{}) (*Repositories, *simpleresty.Response, error) {
+func (r *RepositoriesService) List(ctx context.Context, owner string, opts ...interface{}) (*Repositories, *simpleresty.Response, error) {
 	result := new(Repositories)
 	urlStr, urlStrErr := r.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s", owner), opts...)
@@ -159,7 +160,7 @@ func (r *RepositoriesService) List(owner string, opts ...interface{}) (*Reposito
 		return nil, nil, urlStrErr
 	}
 
-	response, err := r.client.http.Get(urlStr, result, nil)
+	response, err := r.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -167,7 +168,7 @@ func (r *RepositoriesService) List(owner string, opts ...interface{}) (*Reposito
 // Get a single repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D#get
-func (r *RepositoriesService) Get(owner, repoSlug string, opts ...interface{}) (*Repository, *simpleresty.Response, error) {
+func (r *RepositoriesService) Get(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Repository, *simpleresty.Response, error) {
 	result := new(Repository)
 	urlStr, urlStrErr := r.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s", owner, repoSlug), opts...)
@@ -175,7 +176,7 @@ func (r *RepositoriesService) Get(owner, repoSlug string, opts ...interface{}) (
 		return nil, nil, urlStrErr
 	}
 
-	response, err := r.client.http.Get(urlStr, result, nil)
+	response, err := r.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -183,10 +184,10 @@ func (r *RepositoriesService) Get(owner, repoSlug string, opts ...interface{}) (
 // Create a new repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D#post
-func (r *RepositoriesService) Create(owner string, rr *RepositoryRequest) (*Repository, *simpleresty.Response, error) {
+func (r *RepositoriesService) Create(ctx context.Context, owner string, rr *RepositoryRequest) (*Repository, *simpleresty.Response, error) {
 	result := new(Repository)
 	urlStr := r.client.http.RequestURL("/repositories/%s/%s", owner, rr.GetName())
-	response, err := r.client.http.Post(urlStr, result, rr)
+	response, err := r.client.post(ctx, urlStr, result, rr)
 
 	return result, response, err
 }
@@ -194,10 +195,10 @@ func (r *RepositoriesService) Create(owner string, rr *RepositoryRequest) (*Repo
 // Update a repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D#put
-func (r *RepositoriesService) Update(owner, repoSlug string, rr *RepositoryRequest) (*Repository, *simpleresty.Response, error) {
+func (r *RepositoriesService) Update(ctx context.Context, owner, repoSlug string, rr *RepositoryRequest) (*Repository, *simpleresty.Response, error) {
 	result := new(Repository)
 	urlStr := r.client.http.RequestURL("/repositories/%s/%s", owner, repoSlug)
-	response, err := r.client.http.Put(urlStr, result, rr)
+	response, err := r.client.put(ctx, urlStr, result, rr)
 
 	return result, response, err
 }
@@ -206,14 +207,14 @@ func (r *RepositoriesService) Update(owner, repoSlug string, rr *RepositoryReque
 // This is an irreversible operation.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D#delete
-func (r *RepositoriesService) Delete(owner, repoSlug string, deleteOpt *RepositoryDeleteQueryParam) (*simpleresty.Response, error) {
+func (r *RepositoriesService) Delete(ctx context.Context, owner, repoSlug string, deleteOpt *RepositoryDeleteQueryParam) (*simpleresty.Response, error) {
 	urlStr, urlStrErr := r.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s", owner, repoSlug), deleteOpt)
 	if urlStrErr != nil {
 		return nil, urlStrErr
 	}
 
-	response, err := r.client.http.Delete(urlStr, nil, nil)
+	response, err := r.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
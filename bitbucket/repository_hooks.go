@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"time"
@@ -18,8 +19,9 @@ type RepositoryHook struct {
 	UUID        *string    `json:"uuid,omitempty"`
 	URL         *string    `json:"url,omitempty"`
 	Description *string    `json:"description,omitempty"`
-	SubjectType []*string  `json:"subject_type,omitempty"`
+	SubjectType *string    `json:"subject_type,omitempty"`
 	Active      *bool      `json:"active,omitempty"`
+	SecretSet   *bool      `json:"secret_set,omitempty"`
 	CreatedAt   *time.Time `json:"created_at,omitempty"`
 	Events      []*string  `json:"events,omitempty"`
 }
@@ -30,12 +32,15 @@ type RepositoryHookRequest struct {
 	URL         *string   `json:"url,omitempty"`
 	Active      *bool     `json:"active,omitempty"`
 	Events      []*string `json:"events,omitempty"`
+
+	// Secret is used by Bitbucket to sign each request with an X-Hub-Signature header.
+	Secret *string `json:"secret,omitempty"`
 }
 
 // ListHooks returns a paginated list of webhooks installed on a specified repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/hooks#get
-func (r *RepositoriesService) ListHooks(owner, repoSlug string, opts ...interface{}) (*RepositoryHooks, *simpleresty.Response, error) {
+func (r *RepositoriesService) ListHooks(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*RepositoryHooks, *simpleresty.Response, error) {
 	result := new(RepositoryHooks)
 	urlStr, urlStrErr := r.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/hooks", owner, repoSlug), opts...)
@@ -43,7 +48,7 @@ func (r *RepositoriesService) ListHooks(owner, repoSlug string, opts ...interfac
 		return nil, nil, urlStrErr
 	}
 
-	response, err := r.client.http.Get(urlStr, result, nil)
+	response, err := r.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -51,10 +56,10 @@ func (r *RepositoriesService) ListHooks(owner, repoSlug string, opts ...interfac
 // CreateHook creates a new webhook on the specified repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/hooks#post
-func (r *RepositoriesService) CreateHook(owner, repoSlug string, rho *RepositoryHookRequest) (*RepositoryHook, *simpleresty.Response, error) {
+func (r *RepositoriesService) CreateHook(ctx context.Context, owner, repoSlug string, rho *RepositoryHookRequest) (*RepositoryHook, *simpleresty.Response, error) {
 	result := new(RepositoryHook)
 	urlStr := r.client.http.RequestURL("/repositories/%s/%s/hooks", owner, repoSlug)
-	response, err := r.client.http.Post(urlStr, result, rho)
+	response, err := r.client.post(ctx, urlStr, result, rho)
 
 	return result, response, err
 }
@@ -62,7 +67,7 @@ func (r *RepositoriesService) CreateHook(owner, repoSlug string, rho *Repository
 // GetHook returns the webhook with the specified id installed on the specified repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/hooks/%7Buid%7D#get
-func (r *RepositoriesService) GetHook(owner, repoSlug, uid string, opts ...interface{}) (*RepositoryHook, *simpleresty.Response, error) {
+func (r *RepositoriesService) GetHook(ctx context.Context, owner, repoSlug, uid string, opts ...interface{}) (*RepositoryHook, *simpleresty.Response, error) {
 	result := new(RepositoryHook)
 	urlStr, urlStrErr := r.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/hooks/%s", owner, repoSlug, uid), opts...)
@@ -70,7 +75,7 @@ func (r *RepositoriesService) GetHook(owner, repoSlug, uid string, opts ...inter
 		return nil, nil, urlStrErr
 	}
 
-	response, err := r.client.http.Post(urlStr, result, nil)
+	response, err := r.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -78,10 +83,10 @@ func (r *RepositoriesService) GetHook(owner, repoSlug, uid string, opts ...inter
 // UpdateHook updates the specified webhook subscription.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/hooks/%7Buid%7D#put
-func (r *RepositoriesService) UpdateHook(owner, repoSlug, uid string, rho *RepositoryHookRequest) (*RepositoryHook, *simpleresty.Response, error) {
+func (r *RepositoriesService) UpdateHook(ctx context.Context, owner, repoSlug, uid string, rho *RepositoryHookRequest) (*RepositoryHook, *simpleresty.Response, error) {
 	result := new(RepositoryHook)
 	urlStr := r.client.http.RequestURL("/repositories/%s/%s/hooks/%s", owner, repoSlug, uid)
-	response, err := r.client.http.Put(urlStr, result, rho)
+	response, err := r.client.put(ctx, urlStr, result, rho)
 
 	return result, response, err
 }
@@ -90,9 +95,9 @@ func (r *RepositoriesService) UpdateHook(owner, repoSlug, uid string, rho *Repos
 // This is an irreversible operation.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/hooks/%7Buid%7D#delete
-func (r *RepositoriesService) DeleteHook(owner, repoSlug, uid string) (*simpleresty.Response, error) {
+func (r *RepositoriesService) DeleteHook(ctx context.Context, owner, repoSlug, uid string) (*simpleresty.Response, error) {
 	urlStr := r.client.http.RequestURL("/repositories/%s/%s/hooks/%s", owner, repoSlug, uid)
-	response, err := r.client.http.Delete(urlStr, nil, nil)
+	response, err := r.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
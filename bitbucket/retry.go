@@ -0,0 +1,169 @@
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	// DefaultRetryMaxAttempts is the default total number of attempts, including the first request.
+	DefaultRetryMaxAttempts = 4
+
+	// DefaultRetryInitialInterval is the default backoff before the first retry.
+	DefaultRetryInitialInterval = 1 * time.Second
+
+	// DefaultRetryMaxInterval is the default upper bound of a single backoff.
+	DefaultRetryMaxInterval = 30 * time.Second
+)
+
+// RetryPolicy configures how the Client retries failed requests.
+//
+// A request is retried when it fails with a network error or when Bitbucket responds with
+// 429, 502, 503 or 504. The wait before each retry honors the Retry-After header when it is present,
+// and the X-RateLimit-Reset header of a 429, and otherwise uses exponential backoff with jitter.
+type RetryPolicy struct {
+	// MaxAttempts caps the total number of attempts, including the first request.
+	// Defaults to DefaultRetryMaxAttempts.
+	MaxAttempts int
+
+	// MaxElapsedTime caps the total time spent on a request, including waits. A retry that would
+	// end after this deadline is not attempted. Zero means no cap.
+	MaxElapsedTime time.Duration
+
+	// InitialInterval is the backoff before the first retry. It doubles on each following retry.
+	// Defaults to DefaultRetryInitialInterval.
+	InitialInterval time.Duration
+
+	// MaxInterval is the upper bound of a single computed backoff. Defaults to DefaultRetryMaxInterval.
+	MaxInterval time.Duration
+
+	// RetryNonIdempotent allows POST and PATCH requests to be retried as well.
+	// By default only GET, HEAD, OPTIONS, PUT and DELETE requests are retried.
+	RetryNonIdempotent bool
+}
+
+// shouldRetry reports whether the given attempt should be retried and how long to wait before doing so.
+func (p *RetryPolicy) shouldRetry(ctx context.Context, method string, resp *resty.Response, err error,
+	attempt int, elapsed time.Duration) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+
+	if !p.RetryNonIdempotent && !isIdempotent(method) {
+		return 0, false
+	}
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, false
+		}
+	} else if !isRetryableStatus(resp.StatusCode()) {
+		return 0, false
+	}
+
+	wait := p.backoff(attempt)
+	if resp != nil {
+		if d, ok := retryAfter(resp.StatusCode(), resp.Header(), time.Now()); ok {
+			wait = d
+		}
+	}
+
+	if p.MaxElapsedTime > 0 && elapsed+wait > p.MaxElapsedTime {
+		return 0, false
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+		return 0, false
+	}
+
+	return wait, true
+}
+
+// backoff returns the exponential backoff with jitter for the given attempt, starting at 1.
+// The result lies between half and the full computed interval.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	interval := p.InitialInterval
+	for i := 1; i < attempt && interval < p.MaxInterval; i++ {
+		interval *= 2
+	}
+	if interval > p.MaxInterval {
+		interval = p.MaxInterval
+	}
+
+	half := interval / 2
+	if half <= 0 {
+		return interval
+	}
+
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// isIdempotent reports whether a request with the given method can be safely repeated.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRetryableStatus reports whether a response status code is worth retrying.
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns how long Bitbucket asked the client to wait, using the Retry-After header
+// in either its seconds or HTTP-date form, and falling back to the X-RateLimit-Reset header of a 429.
+//
+// X-RateLimit-Reset can be sent with any response, so it is ignored for other statuses, such as a
+// transient 503, which would otherwise wait for the rate limit window to reset instead of backing off.
+func retryAfter(status int, h http.Header, now time.Time) (time.Duration, bool) {
+	if v := h.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return nonNegative(t.Sub(now)), true
+		}
+	}
+
+	if status != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	if reset, ok := parseRateLimitReset(h.Get(headerRateLimitReset)); ok {
+		return nonNegative(reset.Sub(now)), true
+	}
+
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// sleep waits for d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
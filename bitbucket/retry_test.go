@@ -0,0 +1,164 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newFlakyServer(failures int, status int, attempts *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*attempts++
+		w.Header().Set("X-RateLimit-Limit", "1000")
+		w.Header().Set("X-RateLimit-Resource", "api")
+		w.Header().Set("X-RateLimit-NearLimit", "true")
+
+		if *attempts <= failures {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(status)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"slug":"repo"}`))
+	}))
+}
+
+func TestRetry_RetriesThenSucceeds(t *testing.T) {
+	attempts := 0
+	server := newFlakyServer(2, http.StatusTooManyRequests, &attempts)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL),
+		Retry(RetryPolicy{InitialInterval: time.Millisecond}))
+	assert.Nil(t, err)
+
+	repo, _, getErr := client.Repositories.Get(context.Background(), "owner", "repo")
+	assert.Nil(t, getErr)
+	assert.Equal(t, "repo", repo.GetSlug())
+	assert.Equal(t, 3, attempts)
+
+	rl := client.RateLimit()
+	assert.Equal(t, 1000, rl.Limit)
+	assert.Equal(t, -1, rl.Remaining)
+	assert.Equal(t, "api", rl.Resource)
+	assert.True(t, rl.NearLimit)
+}
+
+func TestRetry_MaxAttempts(t *testing.T) {
+	attempts := 0
+	server := newFlakyServer(10, http.StatusServiceUnavailable, &attempts)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL),
+		Retry(RetryPolicy{MaxAttempts: 2, InitialInterval: time.Millisecond}))
+	assert.Nil(t, err)
+
+	_, resp, getErr := client.Repositories.Get(context.Background(), "owner", "repo")
+	assert.NotNil(t, getErr)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetry_SkipsNonIdempotent(t *testing.T) {
+	attempts := 0
+	server := newFlakyServer(1, http.StatusBadGateway, &attempts)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL),
+		Retry(RetryPolicy{InitialInterval: time.Millisecond}))
+	assert.Nil(t, err)
+
+	name := "repo"
+	_, _, createErr := client.Repositories.Create(context.Background(), "owner", &RepositoryRequest{Name: &name})
+	assert.NotNil(t, createErr)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	h := http.Header{}
+	h.Set("Retry-After", "7")
+	d, ok := retryAfter(http.StatusServiceUnavailable, h, now)
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Second, d)
+
+	h = http.Header{}
+	h.Set("Retry-After", now.Add(3*time.Second).Format(http.TimeFormat))
+	d, ok = retryAfter(http.StatusTooManyRequests, h, now)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, d)
+
+	h = http.Header{}
+	h.Set("X-RateLimit-Reset", "1577836810")
+	d, ok = retryAfter(http.StatusTooManyRequests, h, now)
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Second, d)
+
+	// X-RateLimit-Reset only applies to rate limited responses.
+	_, ok = retryAfter(http.StatusServiceUnavailable, h, now)
+	assert.False(t, ok)
+
+	_, ok = retryAfter(http.StatusTooManyRequests, http.Header{}, now)
+	assert.False(t, ok)
+}
+
+func TestRetry_BacksOffOnUnavailableWithRateLimitReset(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"slug":"repo"}`))
+	}))
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL),
+		Retry(RetryPolicy{InitialInterval: time.Millisecond, MaxElapsedTime: time.Minute}))
+	assert.Nil(t, err)
+
+	_, _, getErr := client.Repositories.Get(context.Background(), "owner", "repo")
+	assert.Nil(t, getErr)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetry_ResendsReaderBody(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL),
+		Retry(RetryPolicy{InitialInterval: time.Millisecond}))
+	assert.Nil(t, err)
+
+	req := client.newRequest(context.Background())
+	req.Method = http.MethodPut
+	req.URL = server.URL + "/upload"
+	req.SetBody(strings.NewReader("content"))
+
+	_, sendErr := client.dispatch(req)
+	assert.Nil(t, sendErr)
+	assert.Equal(t, []string{"content", "content"}, bodies)
+}
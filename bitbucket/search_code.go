@@ -4,8 +4,8 @@ package bitbucket
 type SearchCodeResults struct {
 	PaginationInfo
 
-	QuerySubstituted *bool             `json:"query_substituted,omitempty"`
-	Values           *SearchCodeResult `json:"values,omitempty"`
+	QuerySubstituted *bool               `json:"query_substituted,omitempty"`
+	Values           []*SearchCodeResult `json:"values,omitempty"`
 }
 
 // SearchCodeResult represents the individual search query result.
@@ -34,11 +34,16 @@ type SearchMatch struct {
 	Match *bool   `json:"match,omitempty"`
 }
 
-// CodeFile represents the information regarding the file that matched a search query.
+// CodeFile represents a file in a search code result or a diffstat.
+//
+// In a diffstat, Commit is the commit the file version belongs to and EscapedPath is the path
+// escaped for use in a URL.
 type CodeFile struct {
-	Path  *string              `json:"path,omitempty"`
-	Type  *string              `json:"type,omitempty"`
-	Links *SearchCodeFileLinks `json:"links,omitempty"`
+	Path        *string              `json:"path,omitempty"`
+	EscapedPath *string              `json:"escaped_path,omitempty"`
+	Type        *string              `json:"type,omitempty"`
+	Commit      *Commit              `json:"commit,omitempty"`
+	Links       *SearchCodeFileLinks `json:"links,omitempty"`
 }
 
 // SearchCodeFileLinks represents the "links" object in a Bitbucket search code result file.
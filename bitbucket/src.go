@@ -2,11 +2,12 @@ package bitbucket
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/davidji99/simpleresty"
-	"io"
 	"net/url"
+	"strings"
 )
 
 // SRCService handles communication with the src related methods
@@ -15,15 +16,61 @@ import (
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/src
 type SRCService service
 
+const (
+	// SRCTypeFile is the type of the metadata of a file.
+	SRCTypeFile = "commit_file"
+
+	// SRCTypeDirectory is the type of the metadata of a directory.
+	SRCTypeDirectory = "commit_directory"
+)
+
+const (
+	// SRCAttributeExecutable marks a file as executable.
+	SRCAttributeExecutable = "executable"
+
+	// SRCAttributeLink marks a file as a symlink whose content is the link target.
+	SRCAttributeLink = "link"
+
+	// SRCAttributeLFS marks a file stored in Git LFS. Its raw content is the LFS pointer.
+	SRCAttributeLFS = "lfs"
+
+	// SRCAttributeBinary marks a binary file.
+	SRCAttributeBinary = "binary"
+
+	// SRCAttributeSubrepository marks a submodule.
+	SRCAttributeSubrepository = "subrepository"
+)
+
 // SRCMetadata represents a Bitbucket file/folder's metadata on a repository.
 type SRCMetadata struct {
-	Mimetype   *string           `json:"mimetype,omitempty"`
-	Links      *FileHistoryLinks `json:"links,omitempty"`
-	Commit     *Commit           `json:"commit,omitempty"`
-	Attributes []*string         `json:"attributes,omitempty"`
-	Path       *string           `json:"path,omitempty"`
-	Type       *string           `json:"type,omitempty"`
-	Size       *int64            `json:"size,omitempty"`
+	Mimetype    *string           `json:"mimetype,omitempty"`
+	Links       *FileHistoryLinks `json:"links,omitempty"`
+	Commit      *Commit           `json:"commit,omitempty"`
+	Attributes  []*string         `json:"attributes,omitempty"`
+	Path        *string           `json:"path,omitempty"`
+	EscapedPath *string           `json:"escaped_path,omitempty"`
+	Type        *string           `json:"type,omitempty"`
+	Size        *int64            `json:"size,omitempty"`
+}
+
+// IsDir reports whether the metadata describes a directory.
+func (s *SRCMetadata) IsDir() bool {
+	return s.GetType() == SRCTypeDirectory
+}
+
+// HasAttribute reports whether the file has an attribute such as SRCAttributeExecutable or SRCAttributeLFS.
+func (s *SRCMetadata) HasAttribute(attribute string) bool {
+	if s == nil {
+		return false
+	}
+
+	for _, a := range s.Attributes {
+		if a != nil && *a == attribute {
+			return true
+		}
+	}
+
+	return false
 }
 
 // SRCGetOpts represents the query parameters available to SRC#Get requests.
@@ -45,8 +92,12 @@ type srcFormatOpts struct {
 // When path points to a file, this endpoint returns the raw contents. When path points to a directory instead of a file,
 // the response is a paginated list of directory and file objects in the same order as the underlying SCM system would return them.
 //
+// The response does not say which of the two it is, so GetRaw guesses: a JSON body with both "values" and "pagelen"
+// is returned as folderContent and anything else as fileContent. A JSON file of that shape is therefore mistaken
+// for a directory. Use ListDirectory to list a directory, or GetMetadata to check the type of path first.
+//
 // Bitbucket API docs:https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/src/%7Bnode%7D/%7Bpath%7D#get
-func (s *SRCService) GetRaw(owner, repoSlug, nodeRev, path string,
+func (s *SRCService) GetRaw(ctx context.Context, owner, repoSlug, nodeRev, path string,
 	opts ...interface{}) (fileContent *bytes.Buffer, folderContent *FileHistory, resp *simpleresty.Response, err error) {
 
 	encPath := (&url.URL{Path: path}).String()
@@ -56,36 +107,25 @@ func (s *SRCService) GetRaw(owner, repoSlug, nodeRev, path string,
 		return nil, nil, nil, urlStrErr
 	}
 
-	req := s.client.http.NewRequest()
+	req := s.client.newRequest(ctx)
 	req.Method = simpleresty.GetMethod
 	req.URL = urlStr
 
-	resp, reqErr := s.client.http.Dispatch(req)
+	resp, reqErr := s.client.dispatch(req)
 	if reqErr != nil {
-		return nil, nil, nil, reqErr
-	}
-
-	// Attempt to unmarshal the response body as folderContent.
-	// If it works, return as folderContent.
-	decErr := json.NewDecoder(resp.Resp.RawResponse.Body).Decode(&folderContent)
-	if decErr == io.EOF {
-		decErr = nil
-	}
-	if decErr == nil {
-		return nil, folderContent, resp, nil
+		return nil, nil, resp, reqErr
 	}
 
-	// If the unmarshal above doesn't work, raw file content was returned by the API.
-	// Handle and parse the raw content.
-	var buff bytes.Buffer
-	_, parseRawErr := io.Copy(&buff, resp.Resp.RawResponse.Body)
-	if parseRawErr == nil {
-		fileContent = &buff
-		return fileContent, nil, resp, nil
+	// A directory is returned as a JSON page of entries. Any other body, such as a JSON file, is raw content.
+	body := resp.Resp.Body()
+	if strings.HasPrefix(resp.Resp.Header().Get("Content-Type"), "application/json") {
+		listing := new(FileHistory)
+		if json.Unmarshal(body, listing) == nil && listing.Values != nil && listing.Pagelen != nil {
+			return nil, listing, resp, nil
+		}
 	}
 
-	// Return generic error if all else fails.
-	return nil, nil, resp, fmt.Errorf("unable to get the raw or json content for your request")
+	return bytes.NewBuffer(body), nil, resp, nil
 }
 
 // GetMetadata returns the JSON object describing the file or folder's properties,
@@ -95,7 +135,7 @@ func (s *SRCService) GetRaw(owner, repoSlug, nodeRev, path string,
 // listing to only include entries that match certain criteria.
 //
 // Bitbucket API docs:https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/src/%7Bnode%7D/%7Bpath%7D#get
-func (s *SRCService) GetMetadata(owner, repoSlug, nodeRev, path string, opts ...interface{}) (*SRCMetadata, *simpleresty.Response, error) {
+func (s *SRCService) GetMetadata(ctx context.Context, owner, repoSlug, nodeRev, path string, opts ...interface{}) (*SRCMetadata, *simpleresty.Response, error) {
 	result := new(SRCMetadata)
 	encPath := (&url.URL{Path: path}).String()
 
@@ -109,7 +149,7 @@ func (s *SRCService) GetMetadata(owner, repoSlug, nodeRev, path string, opts ...
 		return nil, nil, urlStrErr
 	}
 
-	response, err := s.client.http.Get(urlStr, result, nil)
+	response, err := s.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -0,0 +1,94 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSRCService_Commit(t *testing.T) {
+	fields := map[string][]string{}
+	files := map[string]string{}
+	dispositions := map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/repositories/owner/repo/src", r.URL.Path)
+
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		assert.Nil(t, err)
+
+		mr := multipart.NewReader(r.Body, params["boundary"])
+		for {
+			part, partErr := mr.NextPart()
+			if partErr == io.EOF {
+				break
+			}
+			assert.Nil(t, partErr)
+
+			// The x-attributes parameter is not valid MIME syntax, so files are identified by their Content-ID.
+			content, _ := io.ReadAll(part)
+			path, _ := strconv.Unquote(part.Header.Get("Content-ID"))
+			if path == "" {
+				fields[part.FormName()] = append(fields[part.FormName()], string(content))
+				continue
+			}
+			files[path] = string(content)
+			dispositions[path] = part.Header.Get("Content-Disposition")
+		}
+
+		w.Header().Set("Location", "https://api.bitbucket.org/2.0/repositories/owner/repo/commit/abc123")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	author, branch := "Jane Doe <jane@example.com>", "config-update"
+	req := NewSRCCommitRequest("Update config").
+		AddFile("/config/app.yml", strings.NewReader("replaced")).
+		AddFile("config/app.yml", strings.NewReader("name: app")).
+		AddExecutable("bin/run.sh", strings.NewReader("#!/bin/sh")).
+		AddSymlink("current", "config/app.yml").
+		Delete("config/old.yml")
+	req.Author = &author
+	req.Branch = &branch
+	req.Parents = []string{"def456"}
+
+	commit, _, commitErr := client.SRC.Commit(context.Background(), "owner", "repo", req)
+	assert.Nil(t, commitErr)
+	assert.Equal(t, "abc123", commit.GetHash())
+
+	assert.Equal(t, map[string][]string{
+		"message": {"Update config"},
+		"author":  {author},
+		"branch":  {branch},
+		"parents": {"def456"},
+		"files":   {"config/old.yml"},
+	}, fields)
+	assert.Equal(t, map[string]string{
+		"config/app.yml": "name: app",
+		"bin/run.sh":     "#!/bin/sh",
+		"current":        "config/app.yml",
+	}, files)
+	assert.NotContains(t, dispositions["config/app.yml"], "x-attributes")
+	assert.Contains(t, dispositions["bin/run.sh"], `x-attributes:"executable"`)
+	assert.Contains(t, dispositions["current"], `x-attributes:"link"`)
+}
+
+func TestSRCService_CommitNoChanges(t *testing.T) {
+	client, err := New("user", "password")
+	assert.Nil(t, err)
+
+	_, _, commitErr := client.SRC.Commit(context.Background(), "owner", "repo", NewSRCCommitRequest("Empty"))
+	assert.NotNil(t, commitErr)
+}
@@ -0,0 +1,271 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"io"
+	"io/fs"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SRCWalkFunc is the type of the function called by SRCService.Walk for every file and directory.
+//
+// It follows fs.WalkDirFunc: entry is nil when the metadata of root cannot be read, and a directory
+// whose entries cannot be listed is passed a second time along with the error. Returning fs.SkipDir
+// skips a directory, or the remaining entries of the parent directory of a file. Any other error stops the walk.
+type SRCWalkFunc func(path string, entry *SRCMetadata, err error) error
+
+// ListDirectory returns a page of the files and directories in a directory at a specified revision.
+//
+// An empty path lists the root of the repository. Use SRCGetOpts.MaxDepth to include the entries of subdirectories.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-commit-path-get
+func (s *SRCService) ListDirectory(ctx context.Context, owner, repoSlug, nodeRev, path string, opts ...interface{}) (*FileHistory, *simpleresty.Response, error) {
+	result := new(FileHistory)
+
+	dir := strings.Trim(path, "/")
+	if dir != "" {
+		dir += "/"
+	}
+	encPath := (&url.URL{Path: dir}).String()
+
+	urlStr, urlStrErr := s.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/repositories/%s/%s/src/%s/%s", owner, repoSlug, nodeRev, encPath), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := s.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// Walk walks the tree of a repository at a specified revision, starting at root, in the manner of fs.WalkDir.
+// fn is called for root and for every file and directory below it, in lexical order, with its SRCMetadata.
+//
+// An empty root, "." or "/" walks the whole repository. Paths given to fn are relative to the root of the
+// repository, without a leading slash, and the root of the repository itself is ".".
+func (s *SRCService) Walk(ctx context.Context, owner, repoSlug, nodeRev, root string, fn SRCWalkFunc) error {
+	return fs.WalkDir(s.FS(ctx, owner, repoSlug, nodeRev), fsPath(root), func(p string, d fs.DirEntry, err error) error {
+		var entry *SRCMetadata
+		if d != nil {
+			if info, infoErr := d.Info(); infoErr == nil {
+				entry, _ = info.Sys().(*SRCMetadata)
+			}
+		}
+
+		return fn(p, entry, err)
+	})
+}
+
+// FS returns a read-only fs.FS over the tree of a repository at a specified revision, so that standard
+// tooling such as fs.Glob, fs.WalkDir or template.ParseFS can read it. Every operation is bound to ctx.
+//
+// The fs.FileInfo of a file or directory has its SRCMetadata as Sys, and its ModTime is the date of the
+// commit reported in the metadata, if any. Symlinks are not followed; reading one returns the link target.
+// Files stored in Git LFS are read as their LFS pointer.
+func (s *SRCService) FS(ctx context.Context, owner, repoSlug, nodeRev string) fs.FS {
+	return &srcFS{ctx: ctx, src: s, owner: owner, repoSlug: repoSlug, nodeRev: nodeRev}
+}
+
+// fsPath converts a repository path to a valid fs.FS path.
+func fsPath(p string) string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return "."
+	}
+
+	return p
+}
+
+// srcFS implements fs.FS, fs.StatFS, fs.ReadDirFS and fs.ReadFileFS over SRCService.
+type srcFS struct {
+	ctx      context.Context
+	src      *SRCService
+	owner    string
+	repoSlug string
+	nodeRev  string
+}
+
+// srcPath converts a fs.FS path to the path of the src endpoints.
+func srcPath(name string) string {
+	if name == "." {
+		return ""
+	}
+
+	return name
+}
+
+// pathError wraps err for the given operation, reporting missing files as fs.ErrNotExist.
+func pathError(op, name string, err error) error {
+	if IsNotFound(err) {
+		err = fs.ErrNotExist
+	}
+
+	return &fs.PathError{Op: op, Path: name, Err: err}
+}
+
+// asOpenError reports an error of Stat or ReadFile as an error of Open.
+func asOpenError(err error) error {
+	if pathErr, ok := err.(*fs.PathError); ok {
+		pathErr.Op = "open"
+	}
+
+	return err
+}
+
+func (f *srcFS) Stat(name string) (fs.FileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrInvalid}
+	}
+
+	metadata, _, err := f.src.GetMetadata(f.ctx, f.owner, f.repoSlug, f.nodeRev, srcPath(name))
+	if err != nil {
+		return nil, pathError("stat", name, err)
+	}
+
+	return &srcFileInfo{metadata: metadata, name: path.Base(name)}, nil
+}
+
+func (f *srcFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+
+	values, err := NewPager[*SRCMetadata](f.src.client, func(ctx context.Context, opts ...interface{}) (*FileHistory, *simpleresty.Response, error) {
+		return f.src.ListDirectory(ctx, f.owner, f.repoSlug, f.nodeRev, srcPath(name), opts...)
+	}).All(f.ctx)
+	if err != nil {
+		return nil, pathError("readdir", name, err)
+	}
+
+	entries := make([]fs.DirEntry, 0, len(values))
+	for _, v := range values {
+		entries = append(entries, fs.FileInfoToDirEntry(&srcFileInfo{metadata: v, name: path.Base(v.GetPath())}))
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+
+	return entries, nil
+}
+
+func (f *srcFS) ReadFile(name string) ([]byte, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrInvalid}
+	}
+
+	content, listing, _, err := f.src.GetRaw(f.ctx, f.owner, f.repoSlug, f.nodeRev, srcPath(name))
+	if err != nil {
+		return nil, pathError("read", name, err)
+	}
+	if listing != nil {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fmt.Errorf("is a directory")}
+	}
+
+	return content.Bytes(), nil
+}
+
+func (f *srcFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	info, err := f.Stat(name)
+	if err != nil {
+		return nil, asOpenError(err)
+	}
+
+	if info.IsDir() {
+		return &srcDir{fs: f, name: name, info: info}, nil
+	}
+
+	content, err := f.ReadFile(name)
+	if err != nil {
+		return nil, asOpenError(err)
+	}
+
+	return &srcFile{info: info, Reader: bytes.NewReader(content)}, nil
+}
+
+// srcFileInfo implements fs.FileInfo over SRCMetadata.
+type srcFileInfo struct {
+	metadata *SRCMetadata
+	name     string
+}
+
+func (i *srcFileInfo) Name() string       { return i.name }
+func (i *srcFileInfo) Size() int64        { return i.metadata.GetSize() }
+func (i *srcFileInfo) IsDir() bool        { return i.metadata.IsDir() }
+func (i *srcFileInfo) ModTime() time.Time { return i.metadata.GetCommit().GetDate() }
+func (i *srcFileInfo) Sys() interface{}   { return i.metadata }
+
+func (i *srcFileInfo) Mode() fs.FileMode {
+	switch {
+	case i.metadata.IsDir():
+		return fs.ModeDir | 0o555
+	case i.metadata.HasAttribute(SRCAttributeLink):
+		return fs.ModeSymlink | 0o777
+	case i.metadata.HasAttribute(SRCAttributeExecutable):
+		return 0o555
+	}
+
+	return 0o444
+}
+
+// srcFile implements fs.File for a file whose content has been read.
+type srcFile struct {
+	*bytes.Reader
+	info fs.FileInfo
+}
+
+func (f *srcFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *srcFile) Close() error               { return nil }
+
+// srcDir implements fs.ReadDirFile for a directory. Its entries are listed on the first call to ReadDir.
+type srcDir struct {
+	fs      *srcFS
+	name    string
+	info    fs.FileInfo
+	entries []fs.DirEntry
+	listed  bool
+}
+
+func (d *srcDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *srcDir) Close() error               { return nil }
+
+func (d *srcDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.name, Err: fmt.Errorf("is a directory")}
+}
+
+func (d *srcDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if !d.listed {
+		entries, err := d.fs.ReadDir(d.name)
+		if err != nil {
+			return nil, err
+		}
+		d.entries, d.listed = entries, true
+	}
+
+	if n <= 0 {
+		entries := d.entries
+		d.entries = nil
+		return entries, nil
+	}
+
+	if len(d.entries) == 0 {
+		return nil, io.EOF
+	}
+
+	if n > len(d.entries) {
+		n = len(d.entries)
+	}
+	entries := d.entries[:n]
+	d.entries = d.entries[n:]
+
+	return entries, nil
+}
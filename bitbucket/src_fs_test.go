@@ -0,0 +1,187 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newSRCServer serves the src endpoints for the files of a single revision, one directory entry per page.
+func newSRCServer(files map[string]string, attributes map[string][]string) *httptest.Server {
+	dirs := map[string]bool{"": true}
+	for name := range files {
+		for dir := path.Dir(name); dir != "."; dir = path.Dir(dir) {
+			dirs[dir] = true
+		}
+	}
+
+	metadata := func(name string) map[string]interface{} {
+		if dirs[name] {
+			return map[string]interface{}{"type": SRCTypeDirectory, "path": name}
+		}
+		return map[string]interface{}{"type": SRCTypeFile, "path": name, "size": len(files[name]),
+			"attributes": attributes[name]}
+	}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/repositories/owner/repo/src/rev/")
+		name = strings.TrimSuffix(name, "/")
+		_, isFile := files[name]
+		if !isFile && !dirs[name] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.URL.Query().Get("format") == "meta" {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(metadata(name))
+			return
+		}
+
+		if isFile {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(files[name]))
+			return
+		}
+
+		var entries []string
+		for p := range files {
+			if path.Dir(p) == name || name == "" && path.Dir(p) == "." {
+				entries = append(entries, p)
+			}
+		}
+		for p := range dirs {
+			if p != "" && (path.Dir(p) == name || name == "" && path.Dir(p) == ".") {
+				entries = append(entries, p)
+			}
+		}
+		sort.Sort(sort.Reverse(sort.StringSlice(entries)))
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		listing := map[string]interface{}{"pagelen": 1, "values": []interface{}{}}
+		if page < len(entries) {
+			listing["values"] = []interface{}{metadata(entries[page])}
+		}
+		if page+1 < len(entries) {
+			listing["next"] = fmt.Sprintf("%s%s?page=%d", server.URL, r.URL.Path, page+1)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(listing)
+	}))
+
+	return server
+}
+
+var srcTestFiles = map[string]string{
+	"README.md":           "# repo",
+	"package.json":        `{"name": "repo"}`,
+	"bin/run.sh":          "#!/bin/sh",
+	"docs/guide/intro.md": "intro",
+	"docs/current":        "guide/intro.md",
+	"assets/logo.png":     "version https://git-lfs.github.com/spec/v1",
+}
+
+var srcTestAttributes = map[string][]string{
+	"bin/run.sh":      {SRCAttributeExecutable},
+	"docs/current":    {SRCAttributeLink},
+	"assets/logo.png": {SRCAttributeLFS, SRCAttributeBinary},
+}
+
+func TestSRCService_FS(t *testing.T) {
+	server := newSRCServer(srcTestFiles, srcTestAttributes)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	fsys := client.SRC.FS(context.Background(), "owner", "repo", "rev")
+	assert.Nil(t, fstest.TestFS(fsys, "README.md", "package.json", "bin/run.sh", "docs/guide/intro.md",
+		"docs/current", "assets/logo.png"))
+
+	matches, globErr := fs.Glob(fsys, "docs/*/*.md")
+	assert.Nil(t, globErr)
+	assert.Equal(t, []string{"docs/guide/intro.md"}, matches)
+
+	content, readErr := fs.ReadFile(fsys, "package.json")
+	assert.Nil(t, readErr)
+	assert.Equal(t, `{"name": "repo"}`, string(content))
+
+	info, statErr := fs.Stat(fsys, "bin/run.sh")
+	assert.Nil(t, statErr)
+	assert.Equal(t, fs.FileMode(0o555), info.Mode())
+	assert.Equal(t, int64(9), info.Size())
+
+	_, openErr := fsys.Open("missing.txt")
+	assert.ErrorIs(t, openErr, fs.ErrNotExist)
+}
+
+func TestSRCService_Walk(t *testing.T) {
+	server := newSRCServer(srcTestFiles, srcTestAttributes)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	var visited []string
+	walkErr := client.SRC.Walk(context.Background(), "owner", "repo", "rev", "/", func(p string, entry *SRCMetadata, err error) error {
+		assert.Nil(t, err)
+		if p == "assets" {
+			return fs.SkipDir
+		}
+		if p == "docs/current" {
+			assert.True(t, entry.HasAttribute(SRCAttributeLink))
+		}
+
+		visited = append(visited, fmt.Sprintf("%s %s", p, entry.GetType()))
+		return nil
+	})
+	assert.Nil(t, walkErr)
+	assert.Equal(t, []string{
+		". commit_directory",
+		"README.md commit_file",
+		"bin commit_directory",
+		"bin/run.sh commit_file",
+		"docs commit_directory",
+		"docs/current commit_file",
+		"docs/guide commit_directory",
+		"docs/guide/intro.md commit_file",
+		"package.json commit_file",
+	}, visited)
+
+	walkErr = client.SRC.Walk(context.Background(), "owner", "repo", "rev", "missing", func(p string, entry *SRCMetadata, err error) error {
+		assert.Nil(t, entry)
+		return err
+	})
+	assert.ErrorIs(t, walkErr, fs.ErrNotExist)
+}
+
+func TestSRCService_GetRaw(t *testing.T) {
+	server := newSRCServer(srcTestFiles, srcTestAttributes)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	content, listing, _, rawErr := client.SRC.GetRaw(context.Background(), "owner", "repo", "rev", "package.json")
+	assert.Nil(t, rawErr)
+	assert.Nil(t, listing)
+	assert.Equal(t, `{"name": "repo"}`, content.String())
+
+	content, listing, _, rawErr = client.SRC.GetRaw(context.Background(), "owner", "repo", "rev", "bin")
+	assert.Nil(t, rawErr)
+	assert.Nil(t, content)
+	assert.Equal(t, "bin/run.sh", listing.Values[0].GetPath())
+}
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"time"
@@ -60,7 +61,7 @@ type TeamListOpts struct {
 // Requires 'role' query parameter to be set.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/teams#get
-func (t *TeamsService) List(opts ...interface{}) (*Teams, *simpleresty.Response, error) {
+func (t *TeamsService) List(ctx context.Context, opts ...interface{}) (*Teams, *simpleresty.Response, error) {
 	teams := new(Teams)
 	urlStr, urlStrErr := t.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/teams"), opts...)
@@ -68,7 +69,7 @@ func (t *TeamsService) List(opts ...interface{}) (*Teams, *simpleresty.Response,
 		return nil, nil, urlStrErr
 	}
 
-	response, err := t.client.http.Get(urlStr, teams, nil)
+	response, err := t.client.get(ctx, urlStr, teams, nil)
 
 	return teams, response, err
 }
@@ -78,7 +79,7 @@ func (t *TeamsService) List(opts ...interface{}) (*Teams, *simpleresty.Response,
 // If the team's profile is private, location, website and created_on elements are omitted.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/teams/%7Busername%7D#get
-func (t *TeamsService) Get(teamUsername string, opts ...interface{}) (*Team, *simpleresty.Response, error) {
+func (t *TeamsService) Get(ctx context.Context, teamUsername string, opts ...interface{}) (*Team, *simpleresty.Response, error) {
 	team := new(Team)
 	urlStr, urlStrErr := t.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/teams/%s", teamUsername), opts...)
@@ -86,7 +87,7 @@ func (t *TeamsService) Get(teamUsername string, opts ...interface{}) (*Team, *si
 		return nil, nil, urlStrErr
 	}
 
-	response, err := t.client.http.Get(urlStr, team, nil)
+	response, err := t.client.get(ctx, urlStr, team, nil)
 
 	return team, response, err
 }
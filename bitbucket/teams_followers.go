@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -8,7 +9,7 @@ import (
 // ListFollowers returns the list of accounts that are following this team.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/teams/%7Busername%7D/followers#get
-func (t *TeamsService) ListFollowers(teamUsername string, opts ...interface{}) (*Users, *simpleresty.Response, error) {
+func (t *TeamsService) ListFollowers(ctx context.Context, teamUsername string, opts ...interface{}) (*Users, *simpleresty.Response, error) {
 	result := new(Users)
 	urlStr, urlStrErr := t.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/teams/%s/followers", teamUsername), opts...)
@@ -16,7 +17,7 @@ func (t *TeamsService) ListFollowers(teamUsername string, opts ...interface{}) (
 		return nil, nil, urlStrErr
 	}
 
-	response, err := t.client.http.Get(urlStr, result, nil)
+	response, err := t.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -11,7 +12,9 @@ import (
 // This includes users in groups that may not actually have access to any of the team's repositories.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/teams/%7Busername%7D/members#get
-func (t *TeamsService) ListMembers(teamUsername string, opts ...interface{}) (*Users, *simpleresty.Response, error) {
+//
+// Deprecated: Bitbucket has deprecated the teams endpoints, use WorkspacesService.ListMembers instead.
+func (t *TeamsService) ListMembers(ctx context.Context, teamUsername string, opts ...interface{}) (*Users, *simpleresty.Response, error) {
 	result := new(Users)
 	urlStr, urlStrErr := t.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/teams/%s/members", teamUsername), opts...)
@@ -19,7 +22,7 @@ func (t *TeamsService) ListMembers(teamUsername string, opts ...interface{}) (*U
 		return nil, nil, urlStrErr
 	}
 
-	response, err := t.client.http.Get(urlStr, result, nil)
+	response, err := t.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
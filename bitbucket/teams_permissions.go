@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -41,7 +42,9 @@ type TeamRepoPermission struct {
 // only the highest level is returned.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/teams/%7Busername%7D/permissions#get
-func (t *TeamsService) ListPermissions(teamUsername string, opts ...interface{}) (*TeamPermissions, *simpleresty.Response, error) {
+//
+// Deprecated: Bitbucket has deprecated the teams endpoints, use WorkspacesService.ListPermissions instead.
+func (t *TeamsService) ListPermissions(ctx context.Context, teamUsername string, opts ...interface{}) (*TeamPermissions, *simpleresty.Response, error) {
 	result := new(TeamPermissions)
 	urlStr, urlStrErr := t.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/teams/%s/permissions", teamUsername), opts...)
@@ -49,7 +52,7 @@ func (t *TeamsService) ListPermissions(teamUsername string, opts ...interface{})
 		return nil, nil, urlStrErr
 	}
 
-	response, err := t.client.http.Get(urlStr, result, nil)
+	response, err := t.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -60,7 +63,7 @@ func (t *TeamsService) ListPermissions(teamUsername string, opts ...interface{})
 // an object containing the repository permissions of all the username's repositories will be returned.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/teams/%7Busername%7D/permissions/repositories#get
-func (t *TeamsService) ListRepositoryPermissions(teamUsername string, opts ...interface{}) (*TeamRepoPermissions, *simpleresty.Response, error) {
+func (t *TeamsService) ListRepositoryPermissions(ctx context.Context, teamUsername string, opts ...interface{}) (*TeamRepoPermissions, *simpleresty.Response, error) {
 	result := new(TeamRepoPermissions)
 	urlStr, urlStrErr := t.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/teams/%s/permissions/repositories", teamUsername), opts...)
@@ -68,7 +71,7 @@ func (t *TeamsService) ListRepositoryPermissions(teamUsername string, opts ...in
 		return nil, nil, urlStrErr
 	}
 
-	response, err := t.client.http.Get(urlStr, result, nil)
+	response, err := t.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -79,7 +82,7 @@ func (t *TeamsService) ListRepositoryPermissions(teamUsername string, opts ...in
 // an object containing the repository permissions of the username's repository will be returned.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/teams/%7Busername%7D/permissions/repositories/%7Brepo_slug%7D#get
-func (t *TeamsService) GetRepositoryPermissions(teamUsername, repoSlug string, opts ...interface{}) (*TeamRepoPermissions, *simpleresty.Response, error) {
+func (t *TeamsService) GetRepositoryPermissions(ctx context.Context, teamUsername, repoSlug string, opts ...interface{}) (*TeamRepoPermissions, *simpleresty.Response, error) {
 	result := new(TeamRepoPermissions)
 	urlStr, urlStrErr := t.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/teams/%s/permissions/repositories/%s", teamUsername, repoSlug), opts...)
@@ -87,7 +90,7 @@ func (t *TeamsService) GetRepositoryPermissions(teamUsername, repoSlug string, o
 		return nil, nil, urlStrErr
 	}
 
-	response, err := t.client.http.Get(urlStr, result, nil)
+	response, err := t.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"time"
@@ -45,7 +46,9 @@ type TeamProjectRequest struct {
 // ListProjects returns each project a team has.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/teams/%7Busername%7D/projects/#get
-func (t *TeamsService) ListProjects(teamUsername string, opts ...interface{}) (*TeamProjects, *simpleresty.Response, error) {
+//
+// Deprecated: Bitbucket has deprecated the teams endpoints, use WorkspacesService.ListProjects instead.
+func (t *TeamsService) ListProjects(ctx context.Context, teamUsername string, opts ...interface{}) (*TeamProjects, *simpleresty.Response, error) {
 	result := new(TeamProjects)
 	urlStr, urlStrErr := t.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/teams/%s/projects/", teamUsername), opts...)
@@ -53,7 +56,7 @@ func (t *TeamsService) ListProjects(teamUsername string, opts ...interface{}) (*
 		return nil, nil, urlStrErr
 	}
 
-	response, err := t.client.http.Get(urlStr, result, nil)
+	response, err := t.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -61,10 +64,10 @@ func (t *TeamsService) ListProjects(teamUsername string, opts ...interface{}) (*
 // CreateProject creates a new project.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/teams/%7Busername%7D/projects/#post
-func (t *TeamsService) CreateProject(teamUsername string, po *TeamProjectRequest) (*TeamProject, *simpleresty.Response, error) {
+func (t *TeamsService) CreateProject(ctx context.Context, teamUsername string, po *TeamProjectRequest) (*TeamProject, *simpleresty.Response, error) {
 	result := new(TeamProject)
 	urlStr := t.client.http.RequestURL("/teams/%s/projects/", teamUsername) // Trailing slash is required!
-	response, err := t.client.http.Post(urlStr, result, po)
+	response, err := t.client.post(ctx, urlStr, result, po)
 
 	return result, response, err
 }
@@ -72,10 +75,10 @@ func (t *TeamsService) CreateProject(teamUsername string, po *TeamProjectRequest
 // UpdateProject updates an existing project
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/teams/%7Busername%7D/projects/%7Bproject_key%7D#put
-func (t *TeamsService) UpdateProject(teamUsername, projectKey string, po *TeamProjectRequest) (*TeamProject, *simpleresty.Response, error) {
+func (t *TeamsService) UpdateProject(ctx context.Context, teamUsername, projectKey string, po *TeamProjectRequest) (*TeamProject, *simpleresty.Response, error) {
 	result := new(TeamProject)
 	urlStr := t.client.http.RequestURL("/teams/%s/projects/%s", teamUsername, projectKey)
-	response, err := t.client.http.Put(urlStr, result, po)
+	response, err := t.client.put(ctx, urlStr, result, po)
 
 	return result, response, err
 }
@@ -83,9 +86,9 @@ func (t *TeamsService) UpdateProject(teamUsername, projectKey string, po *TeamPr
 // DeleteProject deletes the specified project.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/teams/%7Busername%7D/projects/%7Bproject_key%7D#delete
-func (t *TeamsService) DeleteProject(teamUsername, projectKey string) (*simpleresty.Response, error) {
+func (t *TeamsService) DeleteProject(ctx context.Context, teamUsername, projectKey string) (*simpleresty.Response, error) {
 	urlStr := t.client.http.RequestURL("/teams/%s/projects/%s", teamUsername, projectKey)
-	response, err := t.client.http.Delete(urlStr, nil, nil)
+	response, err := t.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -8,7 +9,7 @@ import (
 // ListTeamRepositories returns the list of accounts that are following this team.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/teams/%7Busername%7D/repositories#get
-func (t *TeamsService) ListTeamRepositories(teamUsername string, opts ...interface{}) (*Repositories, *simpleresty.Response, error) {
+func (t *TeamsService) ListTeamRepositories(ctx context.Context, teamUsername string, opts ...interface{}) (*Repositories, *simpleresty.Response, error) {
 	result := new(Repositories)
 	urlStr, urlStrErr := t.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/teams/%s/repositories", teamUsername), opts...)
@@ -16,7 +17,7 @@ func (t *TeamsService) ListTeamRepositories(teamUsername string, opts ...interfa
 		return nil, nil, urlStrErr
 	}
 
-	response, err := t.client.http.Get(urlStr, result, nil)
+	response, err := t.client.get(ctx, urlStr, result, nil)
 
 	return result, response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -10,7 +11,9 @@ import (
 // Accepts the user's UUID, account_id, or username. Recommend to use UUID or account_id.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/teams/%7Busername%7D/search/code#get
-func (t *TeamsService) SearchCode(teamUsername string, opts ...interface{}) (*SearchCodeResults, *simpleresty.Response, error) {
+//
+// Deprecated: Bitbucket has deprecated the teams endpoints, use WorkspacesService.SearchCode instead.
+func (t *TeamsService) SearchCode(ctx context.Context, teamUsername string, opts ...interface{}) (*SearchCodeResults, *simpleresty.Response, error) {
 	results := new(SearchCodeResults)
 	urlStr, urlStrErr := t.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/teams/%s/search/code", teamUsername), opts...)
@@ -18,7 +21,7 @@ func (t *TeamsService) SearchCode(teamUsername string, opts ...interface{}) (*Se
 		return nil, nil, urlStrErr
 	}
 
-	response, err := t.client.http.Get(urlStr, results, nil)
+	response, err := t.client.get(ctx, urlStr, results, nil)
 
 	return results, response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"github.com/davidji99/simpleresty"
 	"time"
 )
@@ -32,10 +33,10 @@ type User struct {
 // Get returns the currently authenticated user.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/user#get
-func (u *UserService) Get() (*User, *simpleresty.Response, error) {
+func (u *UserService) Get(ctx context.Context) (*User, *simpleresty.Response, error) {
 	user := new(User)
 	urlStr := u.client.http.RequestURL("/user")
-	response, err := u.client.http.Get(urlStr, user, nil)
+	response, err := u.client.get(ctx, urlStr, user, nil)
 
 	return user, response, err
 }
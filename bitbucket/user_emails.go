@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -29,7 +30,7 @@ type UserEmailLinks struct {
 // GetEmails returns all the authenticated user's email addresses. Both confirmed and unconfirmed.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/user/emails/%7Bemail%7D#get
-func (u *UserService) GetEmails(opts ...interface{}) (*UserEmails, *simpleresty.Response, error) {
+func (u *UserService) GetEmails(ctx context.Context, opts ...interface{}) (*UserEmails, *simpleresty.Response, error) {
 	emails := new(UserEmails)
 	urlStr, urlStrErr := u.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/user/emails"), opts...)
@@ -37,7 +38,7 @@ func (u *UserService) GetEmails(opts ...interface{}) (*UserEmails, *simpleresty.
 		return nil, nil, urlStrErr
 	}
 
-	response, err := u.client.http.Get(urlStr, emails, nil)
+	response, err := u.client.get(ctx, urlStr, emails, nil)
 
 	return emails, response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -26,7 +27,7 @@ type UserRepositoriesPermission struct {
 // and does not distinguish between direct and indirect privileges.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/user/permissions/repositories#get
-func (u *UserService) ListRepositoryPerms(opts ...interface{}) (*UserRepositoriesPermissions, *simpleresty.Response, error) {
+func (u *UserService) ListRepositoryPerms(ctx context.Context, opts ...interface{}) (*UserRepositoriesPermissions, *simpleresty.Response, error) {
 	perms := new(UserRepositoriesPermissions)
 	urlStr, urlStrErr := u.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/user/permissions/repositories"), opts...)
@@ -34,7 +35,7 @@ func (u *UserService) ListRepositoryPerms(opts ...interface{}) (*UserRepositorie
 		return nil, nil, urlStrErr
 	}
 
-	response, err := u.client.http.Get(urlStr, perms, nil)
+	response, err := u.client.get(ctx, urlStr, perms, nil)
 
 	return perms, response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -25,7 +26,7 @@ type UserTeamsPermission struct {
 // If a user is a member of multiple groups with distinct roles, only the highest level is returned.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/user/permissions/teams#get
-func (u *UserService) ListTeamsPerms(opts ...interface{}) (*UserTeamsPermissions, *simpleresty.Response, error) {
+func (u *UserService) ListTeamsPerms(ctx context.Context, opts ...interface{}) (*UserTeamsPermissions, *simpleresty.Response, error) {
 	perms := new(UserTeamsPermissions)
 	urlStr, urlStrErr := u.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/user/permissions/teams"), opts...)
@@ -33,7 +34,7 @@ func (u *UserService) ListTeamsPerms(opts ...interface{}) (*UserTeamsPermissions
 		return nil, nil, urlStrErr
 	}
 
-	response, err := u.client.http.Get(urlStr, perms, nil)
+	response, err := u.client.get(ctx, urlStr, perms, nil)
 
 	return perms, response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -35,7 +36,7 @@ type UserLinks struct {
 // Accepts the user's UUID, account_id, or username. Recommend to use UUID or account_id.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/users/%7Busername%7D#get
-func (u *UsersService) GetByID(userID string, opts ...interface{}) (*User, *simpleresty.Response, error) {
+func (u *UsersService) GetByID(ctx context.Context, userID string, opts ...interface{}) (*User, *simpleresty.Response, error) {
 	user := new(User)
 	urlStr, urlStrErr := u.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/users/%s", userID), opts...)
@@ -43,7 +44,7 @@ func (u *UsersService) GetByID(userID string, opts ...interface{}) (*User, *simp
 		return nil, nil, urlStrErr
 	}
 
-	response, err := u.client.http.Get(urlStr, user, nil)
+	response, err := u.client.get(ctx, urlStr, user, nil)
 
 	return user, response, err
 }
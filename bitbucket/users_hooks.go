@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"time"
@@ -10,7 +11,7 @@ import (
 type UserHooks struct {
 	PaginationInfo
 
-	Values []*Issue `json:"values,omitempty"`
+	Values []*UserHook `json:"values,omitempty"`
 }
 
 // UserHook represents a user hook.
@@ -29,7 +30,7 @@ type UserHook struct {
 // Accepts the user's UUID, account_id, or username. Recommend to use UUID or account_id.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/users/%7Busername%7D/hooks#get
-func (u *UsersService) ListHooks(userID string, opts ...interface{}) (*UserHooks, *simpleresty.Response, error) {
+func (u *UsersService) ListHooks(ctx context.Context, userID string, opts ...interface{}) (*UserHooks, *simpleresty.Response, error) {
 	hooks := new(UserHooks)
 	urlStr, urlStrErr := u.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/users/%s/hooks", userID), opts...)
@@ -37,7 +38,7 @@ func (u *UsersService) ListHooks(userID string, opts ...interface{}) (*UserHooks
 		return nil, nil, urlStrErr
 	}
 
-	response, err := u.client.http.Get(urlStr, hooks, nil)
+	response, err := u.client.get(ctx, urlStr, hooks, nil)
 
 	return hooks, response, err
 }
@@ -47,7 +48,7 @@ func (u *UsersService) ListHooks(userID string, opts ...interface{}) (*UserHooks
 // Accepts the user's UUID, account_id, or username. Recommend to use UUID or account_id.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/users/%7Busername%7D/hooks/%7Buid%7D#get
-func (u *UsersService) GetHook(userID, hookID string, opts ...interface{}) (*UserHook, *simpleresty.Response, error) {
+func (u *UsersService) GetHook(ctx context.Context, userID, hookID string, opts ...interface{}) (*UserHook, *simpleresty.Response, error) {
 	hook := new(UserHook)
 	urlStr, urlStrErr := u.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/users/%s/hooks/%s", userID, hookID), opts...)
@@ -55,7 +56,7 @@ func (u *UsersService) GetHook(userID, hookID string, opts ...interface{}) (*Use
 		return nil, nil, urlStrErr
 	}
 
-	response, err := u.client.http.Get(urlStr, hook, nil)
+	response, err := u.client.get(ctx, urlStr, hook, nil)
 
 	return hook, response, err
 }
@@ -65,9 +66,9 @@ func (u *UsersService) GetHook(userID, hookID string, opts ...interface{}) (*Use
 // Accepts the user's UUID, account_id, or username. Recommend to use UUID or account_id.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/users/%7Busername%7D/hooks/%7Buid%7D#delete
-func (u *UsersService) DeleteHook(userID, hookID string) (*simpleresty.Response, error) {
+func (u *UsersService) DeleteHook(ctx context.Context, userID, hookID string) (*simpleresty.Response, error) {
 	urlStr := u.client.http.RequestURL("/users/%s/hooks/%s", userID, hookID)
-	response, err := u.client.http.Delete(urlStr, nil, nil)
+	response, err := u.client.delete(ctx, urlStr, nil, nil)
 
 	return response, err
 }
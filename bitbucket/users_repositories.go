@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -12,7 +13,7 @@ import (
 // Accepts the user's UUID, account_id, or username. Recommend to use UUID or account_id.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/users/%7Busername%7D/repositories#get
-func (u *UsersService) ListRepositories(userID string, opts ...interface{}) (*Repositories, *simpleresty.Response, error) {
+func (u *UsersService) ListRepositories(ctx context.Context, userID string, opts ...interface{}) (*Repositories, *simpleresty.Response, error) {
 	repos := new(Repositories)
 	urlStr, urlStrErr := u.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/users/%s/repositories", userID), opts...)
@@ -20,7 +21,7 @@ func (u *UsersService) ListRepositories(userID string, opts ...interface{}) (*Re
 		return nil, nil, urlStrErr
 	}
 
-	response, err := u.client.http.Get(urlStr, repos, nil)
+	response, err := u.client.get(ctx, urlStr, repos, nil)
 
 	return repos, response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -10,7 +11,7 @@ import (
 // Accepts the user's UUID, account_id, or username. Recommend to use UUID or account_id.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/users/%7Busername%7D/search/code#get
-func (u *UsersService) SearchCode(userID string, opts ...interface{}) (*SearchCodeResults, *simpleresty.Response, error) {
+func (u *UsersService) SearchCode(ctx context.Context, userID string, opts ...interface{}) (*SearchCodeResults, *simpleresty.Response, error) {
 	results := new(SearchCodeResults)
 	urlStr, urlStrErr := u.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/users/%s/search/code", userID), opts...)
@@ -18,7 +19,7 @@ func (u *UsersService) SearchCode(userID string, opts ...interface{}) (*SearchCo
 		return nil, nil, urlStrErr
 	}
 
-	response, err := u.client.http.Get(urlStr, results, nil)
+	response, err := u.client.get(ctx, urlStr, results, nil)
 
 	return results, response, err
 }
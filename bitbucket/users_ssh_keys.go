@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 	"time"
@@ -42,7 +43,7 @@ type SSHKeyAddRequest struct {
 // Accepts the user's UUID, account_id, or username. Recommend to use UUID or account_id.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/users/%7Busername%7D/ssh-keys#get
-func (u *UsersService) ListSSHKeys(userID string, opts ...interface{}) (*UsersSSHKeys, *simpleresty.Response, error) {
+func (u *UsersService) ListSSHKeys(ctx context.Context, userID string, opts ...interface{}) (*UsersSSHKeys, *simpleresty.Response, error) {
 	sshKeys := new(UsersSSHKeys)
 	urlStr, urlStrErr := u.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/users/%s/ssh-keys", userID), opts...)
@@ -50,7 +51,7 @@ func (u *UsersService) ListSSHKeys(userID string, opts ...interface{}) (*UsersSS
 		return nil, nil, urlStrErr
 	}
 
-	response, err := u.client.http.Get(urlStr, sshKeys, nil)
+	response, err := u.client.get(ctx, urlStr, sshKeys, nil)
 
 	return sshKeys, response, err
 }
@@ -60,10 +61,10 @@ func (u *UsersService) ListSSHKeys(userID string, opts ...interface{}) (*UsersSS
 // Accepts the user's UUID, account_id, or username. Recommend to use UUID or account_id.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/users/%7Busername%7D/ssh-keys#post
-func (u *UsersService) AddSSHKey(userID string, newKey *SSHKeyAddRequest) (*UsersSSHKey, *simpleresty.Response, error) {
+func (u *UsersService) AddSSHKey(ctx context.Context, userID string, newKey *SSHKeyAddRequest) (*UsersSSHKey, *simpleresty.Response, error) {
 	sshKey := new(UsersSSHKey)
 	urlStr := u.client.http.RequestURL("/users/%s/ssh-keys", userID)
-	response, err := u.client.http.Post(urlStr, sshKey, newKey)
+	response, err := u.client.post(ctx, urlStr, sshKey, newKey)
 
 	return sshKey, response, err
 }
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -43,7 +44,7 @@ type VersionRequest struct {
 // List all versions that have been defined in the issue tracker.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/versions#get
-func (v *VersionsService) List(owner, repoSlug string, opts ...interface{}) (*Versions, *simpleresty.Response, error) {
+func (v *VersionsService) List(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Versions, *simpleresty.Response, error) {
 	versions := new(Versions)
 	urlStr, urlStrErr := v.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/versions", owner, repoSlug), opts...)
@@ -51,7 +52,7 @@ func (v *VersionsService) List(owner, repoSlug string, opts ...interface{}) (*Ve
 		return nil, nil, urlStrErr
 	}
 
-	response, err := v.client.http.Get(urlStr, versions, nil)
+	response, err := v.client.get(ctx, urlStr, versions, nil)
 
 	// Parse and store the version id
 	for _, version := range versions.Values {
@@ -65,7 +66,7 @@ func (v *VersionsService) List(owner, repoSlug string, opts ...interface{}) (*Ve
 // NOTE: The version ID is a numerical value, not the version name, that is visible in the links.self.href object.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/versions/%7Bversion_id%7D#get
-func (v *VersionsService) Get(owner, repoSlug string, versionID int64, opts ...interface{}) (*Version, *simpleresty.Response, error) {
+func (v *VersionsService) Get(ctx context.Context, owner, repoSlug string, versionID int64, opts ...interface{}) (*Version, *simpleresty.Response, error) {
 	version := new(Version)
 	urlStr, urlStrErr := v.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/versions/%v", owner, repoSlug, versionID), opts...)
@@ -73,7 +74,7 @@ func (v *VersionsService) Get(owner, repoSlug string, versionID int64, opts ...i
 		return nil, nil, urlStrErr
 	}
 
-	response, err := v.client.http.Get(urlStr, version, nil)
+	response, err := v.client.get(ctx, urlStr, version, nil)
 
 	// Parse and store the version id
 	version.ID = parseForResourceID(versionSelfURLRegex, *version.Links.Self.HRef)
@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"github.com/davidji99/simpleresty"
 )
@@ -14,7 +15,7 @@ type WatchersService service
 // List returns a paginated list of all the watchers on the specified repository.
 //
 // Bitbucket API docs: https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Busername%7D/%7Brepo_slug%7D/watchers#get
-func (w *WatchersService) List(owner, repoSlug string, opts ...interface{}) (*Users, *simpleresty.Response, error) {
+func (w *WatchersService) List(ctx context.Context, owner, repoSlug string, opts ...interface{}) (*Users, *simpleresty.Response, error) {
 	results := new(Users)
 	urlStr, urlStrErr := w.client.http.RequestURLWithQueryParams(
 		fmt.Sprintf("/repositories/%s/%s/watchers", owner, repoSlug), opts...)
@@ -22,7 +23,7 @@ func (w *WatchersService) List(owner, repoSlug string, opts ...interface{}) (*Us
 		return nil, nil, urlStrErr
 	}
 
-	response, err := w.client.http.Get(urlStr, results, nil)
+	response, err := w.client.get(ctx, urlStr, results, nil)
 
 	return results, response, err
 }
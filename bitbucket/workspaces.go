@@ -0,0 +1,90 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"time"
+)
+
+// WorkspacesService handles communication with the workspaces related methods
+// of the Bitbucket API.
+//
+// Workspaces replace the deprecated teams and users endpoints used by TeamsService and UsersService.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/
+type WorkspacesService service
+
+// Workspaces represents a collection of workspaces.
+type Workspaces struct {
+	PaginationInfo
+
+	Values []*Workspace `json:"values,omitempty"`
+}
+
+// Workspace represents a Bitbucket workspace.
+type Workspace struct {
+	Type      *string         `json:"type,omitempty"`
+	UUID      *string         `json:"uuid,omitempty"`
+	Name      *string         `json:"name,omitempty"`
+	Slug      *string         `json:"slug,omitempty"`
+	IsPrivate *bool           `json:"is_private,omitempty"`
+	CreatedOn *time.Time      `json:"created_on,omitempty"`
+	UpdatedOn *time.Time      `json:"updated_on,omitempty"`
+	Links     *WorkspaceLinks `json:"links,omitempty"`
+}
+
+// WorkspaceLinks represents the "links" object in a Bitbucket workspace.
+type WorkspaceLinks struct {
+	Self         *Link `json:"self,omitempty"`
+	HTML         *Link `json:"html,omitempty"`
+	Avatar       *Link `json:"avatar,omitempty"`
+	Members      *Link `json:"members,omitempty"`
+	Owners       *Link `json:"owners,omitempty"`
+	Projects     *Link `json:"projects,omitempty"`
+	Repositories *Link `json:"repositories,omitempty"`
+	Snippets     *Link `json:"snippets,omitempty"`
+	Hooks        *Link `json:"hooks,omitempty"`
+}
+
+// WorkspaceListOpts represents the query parameters available to getting all workspaces.
+type WorkspaceListOpts struct {
+	// Filters the workspaces based on the authenticated user's role on each workspace:
+	//  - member: returns the workspaces the caller is a member of.
+	//  - collaborator: returns the workspaces the caller has write access to at least one repository in.
+	//  - owner: returns the workspaces the caller is an administrator of.
+	Role string `url:"role,omitempty"`
+}
+
+// List returns all the workspaces that the authenticated user has access to.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-get
+func (w *WorkspacesService) List(ctx context.Context, opts ...interface{}) (*Workspaces, *simpleresty.Response, error) {
+	result := new(Workspaces)
+	urlStr, urlStrErr := w.client.http.RequestURLWithQueryParams("/workspaces", opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := w.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// Get returns a single workspace.
+//
+// Accepts the workspace's slug or UUID.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-get
+func (w *WorkspacesService) Get(ctx context.Context, workspace string, opts ...interface{}) (*Workspace, *simpleresty.Response, error) {
+	result := new(Workspace)
+	urlStr, urlStrErr := w.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/workspaces/%s", workspace), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := w.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
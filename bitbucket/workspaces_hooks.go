@@ -0,0 +1,92 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"time"
+)
+
+// WorkspaceHooks represents a collection of workspace hooks.
+type WorkspaceHooks struct {
+	PaginationInfo
+
+	Values []*WorkspaceHook `json:"values,omitempty"`
+}
+
+// WorkspaceHook represents a webhook installed on a workspace.
+type WorkspaceHook struct {
+	UUID        *string    `json:"uuid,omitempty"`
+	URL         *string    `json:"url,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	SubjectType *string    `json:"subject_type,omitempty"`
+	Subject     *Workspace `json:"subject,omitempty"`
+	Active      *bool      `json:"active,omitempty"`
+	SecretSet   *bool      `json:"secret_set,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Events      []*string  `json:"events,omitempty"`
+}
+
+// ListHooks returns the webhooks installed on a workspace.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-hooks-get
+func (w *WorkspacesService) ListHooks(ctx context.Context, workspace string, opts ...interface{}) (*WorkspaceHooks, *simpleresty.Response, error) {
+	result := new(WorkspaceHooks)
+	urlStr, urlStrErr := w.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/workspaces/%s/hooks", workspace), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := w.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// GetHook returns the webhook with the specified id installed on a workspace.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-hooks-uid-get
+func (w *WorkspacesService) GetHook(ctx context.Context, workspace, uid string, opts ...interface{}) (*WorkspaceHook, *simpleresty.Response, error) {
+	result := new(WorkspaceHook)
+	urlStr, urlStrErr := w.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/workspaces/%s/hooks/%s", workspace, uid), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := w.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// CreateHook creates a new webhook on a workspace.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-hooks-post
+func (w *WorkspacesService) CreateHook(ctx context.Context, workspace string, who *RepositoryHookRequest) (*WorkspaceHook, *simpleresty.Response, error) {
+	result := new(WorkspaceHook)
+	urlStr := w.client.http.RequestURL("/workspaces/%s/hooks", workspace)
+	response, err := w.client.post(ctx, urlStr, result, who)
+
+	return result, response, err
+}
+
+// UpdateHook updates the specified webhook subscription of a workspace.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-hooks-uid-put
+func (w *WorkspacesService) UpdateHook(ctx context.Context, workspace, uid string, who *RepositoryHookRequest) (*WorkspaceHook, *simpleresty.Response, error) {
+	result := new(WorkspaceHook)
+	urlStr := w.client.http.RequestURL("/workspaces/%s/hooks/%s", workspace, uid)
+	response, err := w.client.put(ctx, urlStr, result, who)
+
+	return result, response, err
+}
+
+// DeleteHook deletes the specified webhook subscription from a workspace.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-hooks-uid-delete
+func (w *WorkspacesService) DeleteHook(ctx context.Context, workspace, uid string) (*simpleresty.Response, error) {
+	urlStr := w.client.http.RequestURL("/workspaces/%s/hooks/%s", workspace, uid)
+	response, err := w.client.delete(ctx, urlStr, nil, nil)
+
+	return response, err
+}
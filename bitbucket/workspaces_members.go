@@ -0,0 +1,61 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+	"time"
+)
+
+// WorkspaceMemberships represents a collection of workspace memberships.
+type WorkspaceMemberships struct {
+	PaginationInfo
+
+	Values []*WorkspaceMembership `json:"values,omitempty"`
+}
+
+// WorkspaceMembership represents a user's membership of a workspace.
+//
+// Permission is only set when the membership is returned by ListPermissions.
+type WorkspaceMembership struct {
+	Type         *string    `json:"type,omitempty"`
+	Permission   *string    `json:"permission,omitempty"`
+	User         *User      `json:"user,omitempty"`
+	Workspace    *Workspace `json:"workspace,omitempty"`
+	AddedOn      *time.Time `json:"added_on,omitempty"`
+	LastAccessed *time.Time `json:"last_accessed,omitempty"`
+}
+
+// ListMembers returns all members of the specified workspace.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-members-get
+func (w *WorkspacesService) ListMembers(ctx context.Context, workspace string, opts ...interface{}) (*WorkspaceMemberships, *simpleresty.Response, error) {
+	result := new(WorkspaceMemberships)
+	urlStr, urlStrErr := w.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/workspaces/%s/members", workspace), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := w.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// GetMember returns the membership of a single user in the specified workspace.
+//
+// Accepts the member's UUID or account_id.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-members-member-get
+func (w *WorkspacesService) GetMember(ctx context.Context, workspace, member string, opts ...interface{}) (*WorkspaceMembership, *simpleresty.Response, error) {
+	result := new(WorkspaceMembership)
+	urlStr, urlStrErr := w.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/workspaces/%s/members/%s", workspace, member), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := w.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
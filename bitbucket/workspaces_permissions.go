@@ -0,0 +1,58 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+)
+
+// ListPermissions returns the workspace permission of each member of the specified workspace.
+//
+// Permissions returned are effective permissions — if a user is a member of multiple groups with distinct roles,
+// only the highest level is returned.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-permissions-get
+func (w *WorkspacesService) ListPermissions(ctx context.Context, workspace string, opts ...interface{}) (*WorkspaceMemberships, *simpleresty.Response, error) {
+	result := new(WorkspaceMemberships)
+	urlStr, urlStrErr := w.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/workspaces/%s/permissions", workspace), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := w.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// ListRepositoryPermissions returns each repository permission for all of a workspace's repositories.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-permissions-repositories-get
+func (w *WorkspacesService) ListRepositoryPermissions(ctx context.Context, workspace string, opts ...interface{}) (*TeamRepoPermissions, *simpleresty.Response, error) {
+	result := new(TeamRepoPermissions)
+	urlStr, urlStrErr := w.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/workspaces/%s/permissions/repositories", workspace), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := w.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// GetRepositoryPermissions returns each repository permission of a given repository in the workspace.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-permissions-repositories-repo-slug-get
+func (w *WorkspacesService) GetRepositoryPermissions(ctx context.Context, workspace, repoSlug string, opts ...interface{}) (*TeamRepoPermissions, *simpleresty.Response, error) {
+	result := new(TeamRepoPermissions)
+	urlStr, urlStrErr := w.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/workspaces/%s/permissions/repositories/%s", workspace, repoSlug), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := w.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
@@ -0,0 +1,71 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+)
+
+// ListProjects returns each project in a workspace.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-projects/#api-workspaces-workspace-projects-get
+func (w *WorkspacesService) ListProjects(ctx context.Context, workspace string, opts ...interface{}) (*TeamProjects, *simpleresty.Response, error) {
+	result := new(TeamProjects)
+	urlStr, urlStrErr := w.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/workspaces/%s/projects", workspace), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := w.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// GetProject returns a single project in a workspace.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-projects/#api-workspaces-workspace-projects-project-key-get
+func (w *WorkspacesService) GetProject(ctx context.Context, workspace, projectKey string, opts ...interface{}) (*TeamProject, *simpleresty.Response, error) {
+	result := new(TeamProject)
+	urlStr, urlStrErr := w.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/workspaces/%s/projects/%s", workspace, projectKey), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := w.client.get(ctx, urlStr, result, nil)
+
+	return result, response, err
+}
+
+// CreateProject creates a new project in a workspace.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-projects/#api-workspaces-workspace-projects-post
+func (w *WorkspacesService) CreateProject(ctx context.Context, workspace string, po *TeamProjectRequest) (*TeamProject, *simpleresty.Response, error) {
+	result := new(TeamProject)
+	urlStr := w.client.http.RequestURL("/workspaces/%s/projects", workspace)
+	response, err := w.client.post(ctx, urlStr, result, po)
+
+	return result, response, err
+}
+
+// UpdateProject updates an existing project in a workspace.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-projects/#api-workspaces-workspace-projects-project-key-put
+func (w *WorkspacesService) UpdateProject(ctx context.Context, workspace, projectKey string, po *TeamProjectRequest) (*TeamProject, *simpleresty.Response, error) {
+	result := new(TeamProject)
+	urlStr := w.client.http.RequestURL("/workspaces/%s/projects/%s", workspace, projectKey)
+	response, err := w.client.put(ctx, urlStr, result, po)
+
+	return result, response, err
+}
+
+// DeleteProject deletes the specified project. The project must not contain any repositories.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-projects/#api-workspaces-workspace-projects-project-key-delete
+func (w *WorkspacesService) DeleteProject(ctx context.Context, workspace, projectKey string) (*simpleresty.Response, error) {
+	urlStr := w.client.http.RequestURL("/workspaces/%s/projects/%s", workspace, projectKey)
+	response, err := w.client.delete(ctx, urlStr, nil, nil)
+
+	return response, err
+}
@@ -0,0 +1,25 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/davidji99/simpleresty"
+)
+
+// SearchCode searches for code in the repositories of the specified workspace.
+//
+// Use CodeSearchQueryParams to set the search query.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-search/#api-workspaces-workspace-search-code-get
+func (w *WorkspacesService) SearchCode(ctx context.Context, workspace string, opts ...interface{}) (*SearchCodeResults, *simpleresty.Response, error) {
+	results := new(SearchCodeResults)
+	urlStr, urlStrErr := w.client.http.RequestURLWithQueryParams(
+		fmt.Sprintf("/workspaces/%s/search/code", workspace), opts...)
+	if urlStrErr != nil {
+		return nil, nil, urlStrErr
+	}
+
+	response, err := w.client.get(ctx, urlStr, results, nil)
+
+	return results, response, err
+}
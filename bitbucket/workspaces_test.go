@@ -0,0 +1,155 @@
+package bitbucket
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkspacesService_Hooks(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusCreated, `{"uuid":"{h-1}","url":"https://example.com/hook",
+		"subject_type":"workspace","subject":{"slug":"workspace"},"active":true,"secret_set":true,"events":["repo:push"]}`)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	hookURL, active, secret, event := "https://example.com/hook", true, "s3cret", "repo:push"
+	request := &RepositoryHookRequest{URL: &hookURL, Active: &active, Secret: &secret, Events: []*string{&event}}
+	body := map[string]interface{}{"url": hookURL, "active": true, "secret": secret, "events": []interface{}{event}}
+
+	hook, _, createErr := client.Workspaces.CreateHook(ctx, "workspace", request)
+	assert.Nil(t, createErr)
+	assert.Equal(t, capturedRequest{Method: "POST", Path: "/workspaces/workspace/hooks", Body: body}, captured)
+	assert.Equal(t, "workspace", hook.GetSubject().GetSlug())
+	assert.True(t, hook.GetSecretSet())
+	assert.Equal(t, "repo:push", *hook.Events[0])
+
+	_, _, updateErr := client.Workspaces.UpdateHook(ctx, "workspace", "{h-1}", request)
+	assert.Nil(t, updateErr)
+	assert.Equal(t, capturedRequest{Method: "PUT", Path: "/workspaces/workspace/hooks/{h-1}", Body: body}, captured)
+
+	_, _, getErr := client.Workspaces.GetHook(ctx, "workspace", "{h-1}")
+	assert.Nil(t, getErr)
+	assert.Equal(t, "GET", captured.Method)
+	assert.Equal(t, "/workspaces/workspace/hooks/{h-1}", captured.Path)
+
+	_, deleteErr := client.Workspaces.DeleteHook(ctx, "workspace", "{h-1}")
+	assert.Nil(t, deleteErr)
+	assert.Equal(t, "DELETE", captured.Method)
+	assert.Equal(t, "/workspaces/workspace/hooks/{h-1}", captured.Path)
+}
+
+func TestWorkspacesService_Members(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusOK, `{"pagelen":1,"values":[{"type":"workspace_membership",
+		"permission":"owner","user":{"display_name":"Jane"},"workspace":{"slug":"workspace"}}]}`)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	members, _, listErr := client.Workspaces.ListMembers(ctx, "workspace")
+	assert.Nil(t, listErr)
+	assert.Equal(t, "/workspaces/workspace/members", captured.Path)
+	assert.Len(t, members.Values, 1)
+	assert.Equal(t, "Jane", members.Values[0].GetUser().GetDisplayName())
+
+	_, _, getErr := client.Workspaces.GetMember(ctx, "workspace", "{u-1}")
+	assert.Nil(t, getErr)
+	assert.Equal(t, "/workspaces/workspace/members/{u-1}", captured.Path)
+
+	permissions, _, permErr := client.Workspaces.ListPermissions(ctx, "workspace", &ListOpts{Pagelen: 50})
+	assert.Nil(t, permErr)
+	assert.Equal(t, "/workspaces/workspace/permissions", captured.Path)
+	assert.Equal(t, "pagelen=50", captured.Query)
+	assert.Equal(t, "owner", permissions.Values[0].GetPermission())
+}
+
+func TestWorkspacesService_RepositoryPermissions(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusOK, `{"pagelen":1,"values":[{"type":"repository_permission",
+		"permission":"write","user":{"display_name":"Jane"},"repository":{"full_name":"workspace/repo"}}]}`)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	permissions, _, listErr := client.Workspaces.ListRepositoryPermissions(ctx, "workspace")
+	assert.Nil(t, listErr)
+	assert.Equal(t, "GET", captured.Method)
+	assert.Equal(t, "/workspaces/workspace/permissions/repositories", captured.Path)
+	assert.Equal(t, "write", permissions.Values[0].GetPermission())
+	assert.Equal(t, "workspace/repo", permissions.Values[0].GetRepository().GetFullName())
+
+	_, _, getErr := client.Workspaces.GetRepositoryPermissions(ctx, "workspace", "repo")
+	assert.Nil(t, getErr)
+	assert.Equal(t, "/workspaces/workspace/permissions/repositories/repo", captured.Path)
+}
+
+func TestWorkspacesService_Projects(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusCreated,
+		`{"uuid":"{p-1}","key":"PRJ","name":"Project","is_private":true,"type":"project"}`)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	name, key, isPrivate := "Project", "PRJ", true
+	project, _, createErr := client.Workspaces.CreateProject(ctx, "workspace",
+		&TeamProjectRequest{Name: &name, Key: &key, IsPrivate: &isPrivate})
+	assert.Nil(t, createErr)
+	assert.Equal(t, "PRJ", project.GetKey())
+	assert.True(t, project.GetIsPrivate())
+	assert.Equal(t, capturedRequest{Method: "POST", Path: "/workspaces/workspace/projects",
+		Body: map[string]interface{}{"name": name, "key": key, "is_private": true}}, captured)
+
+	description := "Renamed"
+	_, _, updateErr := client.Workspaces.UpdateProject(ctx, "workspace", "PRJ", &TeamProjectRequest{Description: &description})
+	assert.Nil(t, updateErr)
+	assert.Equal(t, capturedRequest{Method: "PUT", Path: "/workspaces/workspace/projects/PRJ",
+		Body: map[string]interface{}{"description": description}}, captured)
+
+	_, _, getErr := client.Workspaces.GetProject(ctx, "workspace", "PRJ")
+	assert.Nil(t, getErr)
+	assert.Equal(t, "GET", captured.Method)
+	assert.Equal(t, "/workspaces/workspace/projects/PRJ", captured.Path)
+
+	_, _, listErr := client.Workspaces.ListProjects(ctx, "workspace")
+	assert.Nil(t, listErr)
+	assert.Equal(t, "/workspaces/workspace/projects", captured.Path)
+
+	_, deleteErr := client.Workspaces.DeleteProject(ctx, "workspace", "PRJ")
+	assert.Nil(t, deleteErr)
+	assert.Equal(t, "DELETE", captured.Method)
+	assert.Equal(t, "/workspaces/workspace/projects/PRJ", captured.Path)
+}
+
+func TestWorkspacesService_SearchCode(t *testing.T) {
+	var captured capturedRequest
+	server := newCapturingServer(&captured, http.StatusOK, `{"pagelen":1,"query_substituted":true,"values":[{
+		"type":"code_search_result","content_match_count":2,"file":{"path":"main.go"}}]}`)
+	defer server.Close()
+
+	client, err := New("user", "password", BaseURL(server.URL))
+	assert.Nil(t, err)
+
+	results, _, searchErr := client.Workspaces.SearchCode(context.Background(), "workspace",
+		&CodeSearchQueryParams{SearchQuery: "func main"})
+	assert.Nil(t, searchErr)
+	assert.Equal(t, "GET", captured.Method)
+	assert.Equal(t, "/workspaces/workspace/search/code", captured.Path)
+	assert.Equal(t, url.Values{"search_query": {"func main"}}.Encode(), captured.Query)
+	assert.True(t, results.GetQuerySubstituted())
+	assert.Equal(t, int64(2), results.Values[0].GetContentMatchCount())
+	assert.Equal(t, "main.go", results.Values[0].GetFile().GetPath())
+}
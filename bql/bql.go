@@ -0,0 +1,274 @@
+// Package bql builds queries in the Bitbucket Query Language, used to filter and sort
+// the collections returned by the Bitbucket API.
+//
+// A query is built from fields, compared to values and combined with And and Or:
+//
+//	q := bql.And(
+//		bql.Field("state").Eq("OPEN"),
+//		bql.Field("source.repository.full_name").Ne("main/repo"),
+//		bql.Field("updated_on").Gt(time.Date(2015, 11, 1, 0, 0, 0, 0, time.UTC)),
+//	)
+//
+//	opts := &bitbucket.FilterSortOpts{Query: q.String(), Sort: bql.Field("updated_on").Desc()}
+//
+// Values are encoded according to their Go type: strings are quoted and escaped, nil and nil pointers
+// become null, time.Time values become unquoted ISO-8601 datetimes and numbers and booleans are written as is.
+//
+// Bitbucket API docs: https://developer.atlassian.com/cloud/bitbucket/rest/intro/#filtering
+package bql
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Comparison operators of the Bitbucket Query Language.
+const (
+	OpEq          = "="
+	OpNe          = "!="
+	OpContains    = "~"
+	OpNotContains = "!~"
+	OpGt          = ">"
+	OpGte         = ">="
+	OpLt          = "<"
+	OpLte         = "<="
+)
+
+// inversions maps each comparison operator to its opposite.
+var inversions = map[string]string{
+	OpEq:          OpNe,
+	OpNe:          OpEq,
+	OpContains:    OpNotContains,
+	OpNotContains: OpContains,
+	OpGt:          OpLte,
+	OpLte:         OpGt,
+	OpLt:          OpGte,
+	OpGte:         OpLt,
+}
+
+// Field refers to a field of the queried objects, using dots to reach nested fields, such as "destination.branch.name".
+type Field string
+
+// Eq matches objects whose field equals v. Use nil to match fields that are null.
+func (f Field) Eq(v interface{}) Query {
+	return f.compare(OpEq, v)
+}
+
+// Ne matches objects whose field does not equal v. Use nil to match fields that are not null.
+func (f Field) Ne(v interface{}) Query {
+	return f.compare(OpNe, v)
+}
+
+// Contains matches objects whose field contains s, ignoring case.
+func (f Field) Contains(s string) Query {
+	return f.compare(OpContains, s)
+}
+
+// NotContains matches objects whose field does not contain s, ignoring case.
+func (f Field) NotContains(s string) Query {
+	return f.compare(OpNotContains, s)
+}
+
+// Gt matches objects whose field is greater than v.
+func (f Field) Gt(v interface{}) Query {
+	return f.compare(OpGt, v)
+}
+
+// Gte matches objects whose field is greater than or equal to v.
+func (f Field) Gte(v interface{}) Query {
+	return f.compare(OpGte, v)
+}
+
+// Lt matches objects whose field is less than v.
+func (f Field) Lt(v interface{}) Query {
+	return f.compare(OpLt, v)
+}
+
+// Lte matches objects whose field is less than or equal to v.
+func (f Field) Lte(v interface{}) Query {
+	return f.compare(OpLte, v)
+}
+
+// IsNull matches objects whose field is null.
+func (f Field) IsNull() Query {
+	return f.compare(OpEq, nil)
+}
+
+// IsNotNull matches objects whose field is not null.
+func (f Field) IsNotNull() Query {
+	return f.compare(OpNe, nil)
+}
+
+// Asc returns the sort parameter that orders results by the field in ascending order.
+func (f Field) Asc() string {
+	return string(f)
+}
+
+// Desc returns the sort parameter that orders results by the field in descending order.
+func (f Field) Desc() string {
+	return "-" + string(f)
+}
+
+func (f Field) compare(op string, v interface{}) Query {
+	return Query{field: f, op: op, value: Value(v)}
+}
+
+// Query is a query expression. The zero value is the empty query, which matches all objects.
+type Query struct {
+	// A comparison has a field, an operator and an encoded value.
+	field Field
+	op    string
+	value string
+
+	// A compound query joins its terms with the "AND" or "OR" in op.
+	terms []Query
+}
+
+// And matches objects that match all queries. Empty queries are ignored.
+func And(queries ...Query) Query {
+	return join("AND", queries)
+}
+
+// Or matches objects that match any of the queries. Empty queries are ignored.
+func Or(queries ...Query) Query {
+	return join("OR", queries)
+}
+
+// Invert replaces each comparison operator of q with its opposite and swaps AND and OR,
+// so Invert(Field("x").Gt(1)) for example is written as "x <= 1".
+//
+// Invert is not a negation of q, which the query language lacks. Objects whose field is null or missing
+// match neither "x > 1" nor "x <= 1", and a comparison of a multi-valued field, such as "reviewers.uuid",
+// matches when any of its values does, so inverting it does not exclude the objects it matched.
+func Invert(q Query) Query {
+	if q.IsEmpty() {
+		return q
+	}
+
+	if q.field != "" {
+		return Query{field: q.field, op: inversions[q.op], value: q.value}
+	}
+
+	terms := make([]Query, len(q.terms))
+	for i, term := range q.terms {
+		terms[i] = Invert(term)
+	}
+
+	op := "AND"
+	if q.op == "AND" {
+		op = "OR"
+	}
+
+	return Query{op: op, terms: terms}
+}
+
+func join(op string, queries []Query) Query {
+	var terms []Query
+	for _, q := range queries {
+		if !q.IsEmpty() {
+			terms = append(terms, q)
+		}
+	}
+
+	switch len(terms) {
+	case 0:
+		return Query{}
+	case 1:
+		return terms[0]
+	default:
+		return Query{op: op, terms: terms}
+	}
+}
+
+// IsEmpty reports whether q is the empty query.
+func (q Query) IsEmpty() bool {
+	return q.field == "" && len(q.terms) == 0
+}
+
+// String returns the query as it goes in FilterSortOpts.Query.
+func (q Query) String() string {
+	if q.IsEmpty() {
+		return ""
+	}
+
+	if q.field != "" {
+		return string(q.field) + " " + q.op + " " + q.value
+	}
+
+	parts := make([]string, len(q.terms))
+	for i, term := range q.terms {
+		parts[i] = term.String()
+		if term.field == "" && term.op != q.op {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+
+	return strings.Join(parts, " "+q.op+" ")
+}
+
+// UUID returns a UUID value, adding the curly braces Bitbucket expects if they are missing.
+func UUID(uuid string) Raw {
+	if !strings.HasPrefix(uuid, "{") {
+		uuid = "{" + uuid + "}"
+	}
+
+	return Raw(Quote(uuid))
+}
+
+// Date returns the date of t, without its time, as an ISO-8601 date value.
+func Date(t time.Time) Raw {
+	return Raw(t.Format("2006-01-02"))
+}
+
+// Quote returns s as a string literal, escaping backslashes and double quotes.
+func Quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// Raw is a value that is written into the query as is, such as a value returned by UUID or Date.
+type Raw string
+
+// Value returns the encoded form of v as it appears on the right-hand side of a comparison.
+//
+// Strings are quoted, nil and nil pointers are null, time.Time values are ISO-8601 datetimes,
+// and booleans and numbers are written as is. Pointers are dereferenced. Value panics for other types.
+func Value(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return "null"
+	case Raw:
+		return string(t)
+	case time.Time:
+		return t.Format(time.RFC3339)
+	}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return "null"
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		return Quote(rv.String())
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, rv.Type().Bits())
+	}
+
+	if t, ok := rv.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+
+	panic(fmt.Sprintf("bql: unsupported value type %T", v))
+}
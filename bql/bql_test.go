@@ -0,0 +1,117 @@
+package bql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type state string
+
+func TestValue(t *testing.T) {
+	str := "OPEN"
+	var nilStr *string
+	date := time.Date(2015, 3, 3, 12, 30, 0, 0, time.FixedZone("", -7*60*60))
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"string", "master", `"master"`},
+		{"escaped string", `say "hi" \o/`, `"say \"hi\" \\o/"`},
+		{"named string", state("OPEN"), `"OPEN"`},
+		{"string pointer", &str, `"OPEN"`},
+		{"nil", nil, "null"},
+		{"nil pointer", nilStr, "null"},
+		{"true", true, "true"},
+		{"int", 42, "42"},
+		{"int64", int64(-7), "-7"},
+		{"uint", uint(7), "7"},
+		{"float", 1.5, "1.5"},
+		{"float32", float32(0.1), "0.1"},
+		{"datetime", date, "2015-03-03T12:30:00-07:00"},
+		{"datetime pointer", &date, "2015-03-03T12:30:00-07:00"},
+		{"date", Date(date), "2015-03-03"},
+		{"uuid", UUID("a1b2"), `"{a1b2}"`},
+		{"braced uuid", UUID("{a1b2}"), `"{a1b2}"`},
+		{"raw", Raw("2015-11-01"), "2015-11-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Value(tt.value))
+		})
+	}
+}
+
+func TestValue_Unsupported(t *testing.T) {
+	assert.Panics(t, func() { Value([]string{"a"}) })
+}
+
+func TestField_Operators(t *testing.T) {
+	f := Field("title")
+
+	tests := []struct {
+		query Query
+		want  string
+	}{
+		{f.Eq("bug"), `title = "bug"`},
+		{f.Ne("bug"), `title != "bug"`},
+		{f.Contains("bug"), `title ~ "bug"`},
+		{f.NotContains("bug"), `title !~ "bug"`},
+		{Field("priority").Gt(1), `priority > 1`},
+		{Field("priority").Gte(1), `priority >= 1`},
+		{Field("priority").Lt(1), `priority < 1`},
+		{Field("priority").Lte(1), `priority <= 1`},
+		{Field("assignee").IsNull(), `assignee = null`},
+		{Field("assignee").IsNotNull(), `assignee != null`},
+		{Field("reviewers.uuid").Eq(UUID("abc")), `reviewers.uuid = "{abc}"`},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.query.String())
+	}
+}
+
+func TestAndOr(t *testing.T) {
+	q := And(
+		Field("source.repository.full_name").Ne("main/repo"),
+		Field("state").Eq("OPEN"),
+		Or(Field("reviewers.username").Eq("evzijst"), Field("author.username").Eq("evzijst")),
+		Field("destination.branch.name").Eq("master"),
+	)
+
+	assert.Equal(t, `source.repository.full_name != "main/repo" AND state = "OPEN" AND `+
+		`(reviewers.username = "evzijst" OR author.username = "evzijst") AND destination.branch.name = "master"`, q.String())
+
+	nested := Or(And(Field("a").Eq(1), Field("b").Eq(2)), Or(Field("c").Eq(3), Field("d").Eq(4)))
+	assert.Equal(t, `(a = 1 AND b = 2) OR c = 3 OR d = 4`, nested.String())
+}
+
+func TestAndOr_Empty(t *testing.T) {
+	assert.True(t, And().IsEmpty())
+	assert.Equal(t, "", Or(Query{}, Query{}).String())
+	assert.Equal(t, `a = 1`, And(Query{}, Field("a").Eq(1)).String())
+}
+
+func TestInvert(t *testing.T) {
+	assert.Equal(t, `state != "OPEN"`, Invert(Field("state").Eq("OPEN")).String())
+	assert.Equal(t, `title !~ "wip"`, Invert(Field("title").Contains("wip")).String())
+	assert.Equal(t, `priority <= 1`, Invert(Field("priority").Gt(1)).String())
+	assert.Equal(t, `priority > 1`, Invert(Field("priority").Lte(1)).String())
+	assert.Equal(t, `priority >= 1`, Invert(Field("priority").Lt(1)).String())
+	assert.Equal(t, `priority < 1`, Invert(Field("priority").Gte(1)).String())
+
+	q := Invert(And(Field("state").Eq("OPEN"), Or(Field("a").Eq(1), Field("b").IsNull())))
+	assert.Equal(t, `state != "OPEN" OR (a != 1 AND b != null)`, q.String())
+
+	assert.Equal(t, `a = 1`, Invert(Invert(Field("a").Eq(1))).String())
+	assert.True(t, Invert(Query{}).IsEmpty())
+}
+
+func TestField_Sort(t *testing.T) {
+	assert.Equal(t, "updated_on", Field("updated_on").Asc())
+	assert.Equal(t, "-updated_on", Field("updated_on").Desc())
+}
@@ -0,0 +1,98 @@
+// Package cassette records the HTTP interactions of a client to a file and replays them offline.
+//
+// A Recorder is an http.RoundTripper. In ModeRecord it sends requests to the network and saves each request
+// and response pair when Stop is called; in ModeReplay it answers requests from the saved pairs without
+// touching the network. Pass it to a client with the bitbucket.Transport option, which also hands it the
+// client's credentials so they are never written to disk:
+//
+//	rec, err := cassette.New("testdata/repositories.yaml", cassette.ModeFromEnv("BITBUCKET_CASSETTE", cassette.ModeReplay))
+//	if err != nil { ... }
+//	defer rec.Stop()
+//
+//	client, err := bitbucket.New(user, appPassword, bitbucket.Transport(rec))
+package cassette
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Cassette represents the recorded interactions saved in a cassette file.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions" yaml:"interactions"`
+}
+
+// Interaction represents a single request and the response it received.
+type Interaction struct {
+	Request  Request  `json:"request" yaml:"request"`
+	Response Response `json:"response" yaml:"response"`
+}
+
+// Request represents a recorded HTTP request.
+type Request struct {
+	Method  string      `json:"method" yaml:"method"`
+	URL     string      `json:"url" yaml:"url"`
+	Headers http.Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body    string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// Response represents a recorded HTTP response.
+type Response struct {
+	StatusCode int         `json:"status_code" yaml:"status_code"`
+	Headers    http.Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body       string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// load reads a cassette file, decoding it as JSON when its extension is ".json" and as YAML otherwise.
+func load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: %w", err)
+	}
+
+	c := new(Cassette)
+	if isJSON(path) {
+		err = json.Unmarshal(data, c)
+	} else {
+		err = yaml.Unmarshal(data, c)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cassette: decoding %s: %w", path, err)
+	}
+
+	return c, nil
+}
+
+// save writes a cassette file, creating its directory if needed.
+func (c *Cassette) save(path string) error {
+	var data []byte
+	var err error
+	if isJSON(path) {
+		data, err = json.MarshalIndent(c, "", "  ")
+	} else {
+		data, err = yaml.Marshal(c)
+	}
+	if err != nil {
+		return fmt.Errorf("cassette: encoding %s: %w", path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+
+	return nil
+}
+
+func isJSON(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".json")
+}
@@ -0,0 +1,55 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+)
+
+// Matcher reports whether a live request, whose body has already been read, matches a recorded request.
+type Matcher func(r *http.Request, body []byte, recorded Request) bool
+
+// DefaultMatchers are the matchers a Recorder uses unless Matchers is given.
+var DefaultMatchers = []Matcher{MatchMethod, MatchPath, MatchQuery, MatchBody}
+
+// MatchMethod matches requests with the same HTTP method.
+func MatchMethod(r *http.Request, _ []byte, recorded Request) bool {
+	return r.Method == recorded.Method
+}
+
+// MatchPath matches requests with the same URL path.
+func MatchPath(r *http.Request, _ []byte, recorded Request) bool {
+	u, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+
+	return r.URL.Path == u.Path
+}
+
+// MatchQuery matches requests with the same query parameters, regardless of their order.
+func MatchQuery(r *http.Request, _ []byte, recorded Request) bool {
+	u, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(r.URL.Query(), u.Query())
+}
+
+// MatchBody matches requests with the same body. JSON bodies are compared by value,
+// so differences in key order or whitespace are ignored.
+func MatchBody(_ *http.Request, body []byte, recorded Request) bool {
+	if bytes.Equal(body, []byte(recorded.Body)) {
+		return true
+	}
+
+	var live, saved interface{}
+	if json.Unmarshal(body, &live) != nil || json.Unmarshal([]byte(recorded.Body), &saved) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(live, saved)
+}
@@ -0,0 +1,280 @@
+package cassette
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Mode selects whether a Recorder records or replays interactions.
+type Mode int
+
+const (
+	// ModeReplay answers requests from the cassette file and fails requests that were not recorded.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the network and saves the interactions to the cassette file on Stop.
+	ModeRecord
+)
+
+// Redacted replaces credentials in recorded interactions.
+const Redacted = "REDACTED"
+
+// sensitiveHeaders are never written to a cassette.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// ModeFromEnv returns ModeRecord when the environment variable key is "record",
+// ModeReplay when it is "replay" and fallback otherwise.
+func ModeFromEnv(key string, fallback Mode) Mode {
+	switch strings.ToLower(os.Getenv(key)) {
+	case "record":
+		return ModeRecord
+	case "replay":
+		return ModeReplay
+	default:
+		return fallback
+	}
+}
+
+// RecorderOption is a functional option for configuring a Recorder.
+type RecorderOption func(*Recorder)
+
+// Matchers sets the matchers a request must satisfy to be answered by a recorded interaction.
+func Matchers(matchers ...Matcher) RecorderOption {
+	return func(r *Recorder) {
+		r.matchers = matchers
+	}
+}
+
+// Transport sets the transport used to send requests in ModeRecord. It defaults to http.DefaultTransport.
+func Transport(rt http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.transport = rt
+	}
+}
+
+// Recorder is an http.RoundTripper that records interactions to, or replays them from, a cassette file.
+type Recorder struct {
+	path      string
+	mode      Mode
+	matchers  []Matcher
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette *Cassette
+	replayed []bool
+	secrets  []string
+}
+
+// New returns a Recorder for the cassette file at path. Files ending in ".json" are written as JSON,
+// all others as YAML. In ModeReplay the file must exist.
+func New(path string, mode Mode, opts ...RecorderOption) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		matchers:  DefaultMatchers,
+		transport: http.DefaultTransport,
+		cassette:  new(Cassette),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if mode == ModeReplay {
+		c, err := load(path)
+		if err != nil {
+			return nil, err
+		}
+
+		r.cassette = c
+		r.replayed = make([]bool, len(c.Interactions))
+	}
+
+	return r, nil
+}
+
+// Mode returns the mode of the Recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Redact registers secrets that are replaced by Redacted wherever they appear in recorded
+// URLs, headers and bodies. Secrets already registered are ignored. The bitbucket.Transport
+// option registers the client's credentials before every request.
+func (r *Recorder) Redact(secrets ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, secret := range secrets {
+		if secret != "" && !r.redacts(secret) {
+			r.secrets = append(r.secrets, secret)
+		}
+	}
+}
+
+// redacts reports whether secret is registered. r.mu must be held.
+func (r *Recorder) redacts(secret string) bool {
+	for _, s := range r.secrets {
+		if s == secret {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Stop saves the recorded interactions to the cassette file. It does nothing in ModeReplay.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.save(r.path)
+}
+
+// RoundTrip records or replays a single request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("cassette: reading request body: %w", err)
+		}
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, body)
+	}
+
+	return r.replay(req, body)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	out.Body = io.NopCloser(bytes.NewReader(body))
+
+	resp, err := r.transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("cassette: reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: Request{
+			Method:  req.Method,
+			URL:     r.redact(req.URL.String()),
+			Headers: r.redactHeaders(req.Header),
+			Body:    r.redact(string(body)),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    r.redactHeaders(resp.Header),
+			Body:       r.redact(string(respBody)),
+		},
+	})
+
+	return resp, nil
+}
+
+// replay answers req with the first matching interaction that has not been replayed yet.
+// Once all matching interactions have been replayed, the last one answers further requests.
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Match against the request as it would have been recorded.
+	redacted := req.Clone(req.Context())
+	redacted.URL.RawQuery = r.redact(req.URL.RawQuery)
+	redacted.URL.Path = r.redact(req.URL.Path)
+	body = []byte(r.redact(string(body)))
+
+	found := -1
+	for i, interaction := range r.cassette.Interactions {
+		if !r.matches(redacted, body, interaction.Request) {
+			continue
+		}
+
+		found = i
+		if !r.replayed[i] {
+			break
+		}
+	}
+
+	if found < 0 {
+		return nil, fmt.Errorf("cassette: no interaction in %s matches %s %s", r.path, req.Method, redacted.URL.String())
+	}
+	r.replayed[found] = true
+
+	recorded := r.cassette.Interactions[found].Response
+	header := recorded.Headers.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) matches(req *http.Request, body []byte, recorded Request) bool {
+	for _, match := range r.matchers {
+		if !match(req, body, recorded) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// redact replaces every registered secret in s.
+func (r *Recorder) redact(s string) string {
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, Redacted)
+	}
+
+	return s
+}
+
+// redactHeaders returns a copy of h without sensitive headers and with registered secrets replaced.
+func (r *Recorder) redactHeaders(h http.Header) http.Header {
+	out := h.Clone()
+	for _, name := range sensitiveHeaders {
+		out.Del(name)
+	}
+
+	for name, values := range out {
+		for i, value := range values {
+			values[i] = r.redact(value)
+		}
+		out[name] = values
+	}
+
+	return out
+}
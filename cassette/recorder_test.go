@@ -0,0 +1,139 @@
+package cassette
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/davidji99/bitbucket-go/bitbucket"
+	"github.com/stretchr/testify/assert"
+)
+
+func newServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repositories/owner/repo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+		fmt.Fprint(w, `{"slug":"repo","full_name":"owner/repo"}`)
+	})
+	mux.HandleFunc("/repositories/owner/repo/issues", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":1,"title":"bug"}`)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	for _, name := range []string{"cassette.yaml", "cassette.json"} {
+		t.Run(name, func(t *testing.T) {
+			server := newServer(t)
+			path := filepath.Join(t.TempDir(), "testdata", name)
+
+			rec, err := New(path, ModeRecord)
+			assert.Nil(t, err)
+
+			client, err := bitbucket.New("user", "app-password", bitbucket.BaseURL(server.URL), bitbucket.Transport(rec))
+			assert.Nil(t, err)
+
+			repo, _, err := client.Repositories.Get(context.Background(), "owner", "repo")
+			assert.Nil(t, err)
+			assert.Equal(t, "owner/repo", repo.GetFullName())
+
+			title := "bug"
+			issue, _, err := client.Issues.Create(context.Background(), "owner", "repo", &bitbucket.IssueRequest{Title: &title})
+			assert.Nil(t, err)
+			assert.Equal(t, int64(1), issue.GetID())
+
+			assert.Nil(t, rec.Stop())
+			server.Close()
+
+			data, err := os.ReadFile(path)
+			assert.Nil(t, err)
+			assert.False(t, strings.Contains(string(data), "Authorization"))
+			assert.False(t, strings.Contains(string(data), "session=abc"))
+			assert.False(t, strings.Contains(string(data), "dXNlcjphcHAtcGFzc3dvcmQ="))
+
+			// Replay with the server gone.
+			rec, err = New(path, ModeReplay)
+			assert.Nil(t, err)
+
+			client, err = bitbucket.New("user", "app-password", bitbucket.BaseURL(server.URL), bitbucket.Transport(rec))
+			assert.Nil(t, err)
+
+			repo, _, err = client.Repositories.Get(context.Background(), "owner", "repo")
+			assert.Nil(t, err)
+			assert.Equal(t, "owner/repo", repo.GetFullName())
+
+			issue, response, err := client.Issues.Create(context.Background(), "owner", "repo", &bitbucket.IssueRequest{Title: &title})
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusCreated, response.StatusCode)
+			assert.Equal(t, "bug", issue.GetTitle())
+
+			other := "other"
+			_, _, err = client.Issues.Create(context.Background(), "owner", "repo", &bitbucket.IssueRequest{Title: &other})
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestRecorder_RedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"echo":%q}`, r.URL.Query().Get("token"))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.yaml")
+	rec, err := New(path, ModeRecord)
+	assert.Nil(t, err)
+	rec.Redact("s3cret")
+	rec.Redact("s3cret", "")
+	assert.Equal(t, []string{"s3cret"}, rec.secrets)
+
+	client := &http.Client{Transport: rec}
+	resp, err := client.Get(server.URL + "/?token=s3cret")
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Nil(t, rec.Stop())
+
+	data, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.False(t, strings.Contains(string(data), "s3cret"))
+
+	// The live secret matches the redacted recording.
+	rec, err = New(path, ModeReplay)
+	assert.Nil(t, err)
+	rec.Redact("s3cret")
+
+	resp, err = (&http.Client{Transport: rec}).Get(server.URL + "/?token=s3cret")
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNew_ReplayMissingFile(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing.yaml"), ModeReplay)
+	assert.NotNil(t, err)
+}
+
+func TestModeFromEnv(t *testing.T) {
+	t.Setenv("BITBUCKET_CASSETTE", "record")
+	assert.Equal(t, ModeRecord, ModeFromEnv("BITBUCKET_CASSETTE", ModeReplay))
+
+	t.Setenv("BITBUCKET_CASSETTE", "")
+	assert.Equal(t, ModeReplay, ModeFromEnv("BITBUCKET_CASSETTE", ModeReplay))
+}
+
+func TestMatchBody_JSON(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	assert.True(t, MatchBody(req, []byte(`{"b":1,"a":2}`), Request{Body: `{"a":2, "b":1}`}))
+	assert.False(t, MatchBody(req, []byte(`{"a":1}`), Request{Body: `{"a":2}`}))
+}
@@ -0,0 +1,423 @@
+// Package diff parses the unified, git-style diffs returned by DiffService.GetRaw, PatchService.GetRaw,
+// PullRequestsService.GetDiffRaw and PullRequestsService.GetPatchRaw into files, hunks and lines.
+//
+//	raw, _, err := client.PullRequests.GetDiffRaw(ctx, owner, repoSlug, id)
+//	if err != nil { ... }
+//
+//	files, err := diff.Parse(raw)
+//	if err != nil { ... }
+//
+//	for _, f := range files {
+//		for _, h := range f.Hunks {
+//			for _, l := range h.Lines {
+//				fmt.Println(f.Path(), l.OldNumber, l.NewNumber, l.Kind, l.Content)
+//			}
+//		}
+//	}
+//
+// Patches are parsed the same way; the mail headers and commit messages around each diff are skipped.
+package diff
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Status is how a File changed. Its values match the statuses of the Bitbucket diffstat.
+type Status string
+
+const (
+	StatusAdded    Status = "added"
+	StatusRemoved  Status = "removed"
+	StatusModified Status = "modified"
+	StatusRenamed  Status = "renamed"
+)
+
+// Kind is the kind of a Line.
+type Kind byte
+
+const (
+	// Context is an unchanged line shown around changes.
+	Context Kind = ' '
+
+	// Added is a line that only exists in the new version of a file.
+	Added Kind = '+'
+
+	// Removed is a line that only exists in the old version of a file.
+	Removed Kind = '-'
+)
+
+// String returns the prefix of the kind of line in a diff.
+func (k Kind) String() string {
+	return string(k)
+}
+
+// File represents the changes to a single file.
+type File struct {
+	// OldPath and NewPath are the paths of the file before and after the change.
+	// OldPath is empty for added files and NewPath is empty for removed files.
+	OldPath string
+	NewPath string
+
+	// OldMode and NewMode are the file modes, such as "100644", when the diff reports them.
+	OldMode string
+	NewMode string
+
+	// Status is one of StatusAdded, StatusRemoved, StatusModified or StatusRenamed.
+	Status Status
+
+	// Similarity is the similarity index of a renamed or copied file, as a percentage.
+	Similarity int
+
+	// Binary reports whether the file is binary, in which case it has no hunks.
+	Binary bool
+
+	Hunks []*Hunk
+}
+
+// Path returns the path of the file after the change, or before it for removed files.
+func (f *File) Path() string {
+	if f.NewPath != "" {
+		return f.NewPath
+	}
+
+	return f.OldPath
+}
+
+// ModeChanged reports whether the change alters the mode of the file.
+func (f *File) ModeChanged() bool {
+	return f.OldMode != "" && f.NewMode != "" && f.OldMode != f.NewMode
+}
+
+// Stats returns the number of added and removed lines.
+func (f *File) Stats() (added, removed int) {
+	for _, h := range f.Hunks {
+		for _, l := range h.Lines {
+			switch l.Kind {
+			case Added:
+				added++
+			case Removed:
+				removed++
+			}
+		}
+	}
+
+	return added, removed
+}
+
+// Hunk represents a contiguous block of changes in a file.
+type Hunk struct {
+	// OldStart and OldLines are the first line and the number of lines of the hunk in the old file,
+	// NewStart and NewLines in the new file.
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+
+	// Section is the text following the hunk header, usually the enclosing function.
+	Section string
+
+	Lines []*Line
+}
+
+// Line represents a single line of a hunk.
+type Line struct {
+	Kind    Kind
+	Content string
+
+	// OldNumber is the line number in the old file and NewNumber the line number in the new file.
+	// OldNumber is zero for added lines and NewNumber is zero for removed lines.
+	OldNumber int
+	NewNumber int
+
+	// Position is the position of the line in the diff of its file. The line after the first
+	// hunk header is at position 1, and every following hunk header also takes a position.
+	Position int
+
+	// NoNewline reports whether the line is the last of its file and has no trailing newline.
+	NoNewline bool
+}
+
+// Parse parses the unified, git-style diff in r.
+func Parse(r io.Reader) ([]*File, error) {
+	p := &parser{scanner: bufio.NewScanner(r)}
+	p.scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	return p.parse()
+}
+
+type parser struct {
+	scanner *bufio.Scanner
+	lineNo  int
+
+	files    []*File
+	file     *File
+	hunk     *Hunk
+	position int
+
+	// oldLeft and newLeft count the lines the current hunk still has to read.
+	oldLeft int
+	newLeft int
+}
+
+func (p *parser) parse() ([]*File, error) {
+	for p.scanner.Scan() {
+		p.lineNo++
+		if err := p.parseLine(p.scanner.Text()); err != nil {
+			return nil, fmt.Errorf("diff: line %d: %w", p.lineNo, err)
+		}
+	}
+
+	if err := p.scanner.Err(); err != nil {
+		return nil, fmt.Errorf("diff: %w", err)
+	}
+
+	if p.oldLeft > 0 || p.newLeft > 0 {
+		return nil, fmt.Errorf("diff: line %d: unexpected end of hunk", p.lineNo)
+	}
+
+	return p.files, nil
+}
+
+func (p *parser) parseLine(line string) error {
+	if p.hunk != nil && (p.oldLeft > 0 || p.newLeft > 0) {
+		return p.parseHunkLine(line)
+	}
+
+	if p.hunk != nil && strings.HasPrefix(line, `\`) {
+		// "\ No newline at end of file" follows the last line of a hunk.
+		if n := len(p.hunk.Lines); n > 0 {
+			p.hunk.Lines[n-1].NoNewline = true
+		}
+		return nil
+	}
+
+	switch {
+	case strings.HasPrefix(line, "diff --git "):
+		p.startFile(line)
+		return nil
+	case p.file == nil:
+		// Mail headers and commit messages of a patch.
+		return nil
+	case strings.HasPrefix(line, "@@ "):
+		return p.startHunk(line)
+	case p.hunk != nil:
+		// Text after the last hunk of a file, such as the signature of a patch.
+		return nil
+	}
+
+	return p.parseHeader(line)
+}
+
+func (p *parser) startFile(line string) {
+	oldPath, newPath := splitGitPaths(strings.TrimPrefix(line, "diff --git "))
+	p.file = &File{OldPath: oldPath, NewPath: newPath, Status: StatusModified}
+	p.files = append(p.files, p.file)
+	p.hunk = nil
+	p.position = 0
+}
+
+func (p *parser) parseHeader(line string) error {
+	f := p.file
+
+	switch {
+	case strings.HasPrefix(line, "new file mode "):
+		f.Status = StatusAdded
+		f.OldPath = ""
+		f.NewMode = strings.TrimPrefix(line, "new file mode ")
+	case strings.HasPrefix(line, "deleted file mode "):
+		f.Status = StatusRemoved
+		f.NewPath = ""
+		f.OldMode = strings.TrimPrefix(line, "deleted file mode ")
+	case strings.HasPrefix(line, "old mode "):
+		f.OldMode = strings.TrimPrefix(line, "old mode ")
+	case strings.HasPrefix(line, "new mode "):
+		f.NewMode = strings.TrimPrefix(line, "new mode ")
+	case strings.HasPrefix(line, "similarity index "):
+		f.Similarity, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(line, "similarity index "), "%"))
+	case strings.HasPrefix(line, "rename from "):
+		f.Status = StatusRenamed
+		f.OldPath = unquote(strings.TrimPrefix(line, "rename from "))
+	case strings.HasPrefix(line, "rename to "):
+		f.Status = StatusRenamed
+		f.NewPath = unquote(strings.TrimPrefix(line, "rename to "))
+	case strings.HasPrefix(line, "copy from "):
+		f.OldPath = unquote(strings.TrimPrefix(line, "copy from "))
+	case strings.HasPrefix(line, "copy to "):
+		f.Status = StatusAdded
+		f.NewPath = unquote(strings.TrimPrefix(line, "copy to "))
+	case strings.HasPrefix(line, "index "):
+		// "index abc123..def456 100644" carries the mode of files whose mode did not change.
+		if fields := strings.Fields(line); len(fields) == 3 && f.OldMode == "" && f.NewMode == "" {
+			f.OldMode, f.NewMode = fields[2], fields[2]
+		}
+	case strings.HasPrefix(line, "Binary files "), line == "GIT binary patch":
+		f.Binary = true
+	case strings.HasPrefix(line, "--- "):
+		if path := stripPrefix(unquote(strings.TrimPrefix(line, "--- ")), "a/"); path != "/dev/null" {
+			f.OldPath = path
+		}
+	case strings.HasPrefix(line, "+++ "):
+		if path := stripPrefix(unquote(strings.TrimPrefix(line, "+++ ")), "b/"); path != "/dev/null" {
+			f.NewPath = path
+		}
+	}
+
+	return nil
+}
+
+func (p *parser) startHunk(line string) error {
+	// @@ -oldStart[,oldLines] +newStart[,newLines] @@ section
+	end := strings.Index(line[3:], " @@")
+	if end < 0 {
+		return fmt.Errorf("malformed hunk header %q", line)
+	}
+
+	ranges := strings.Fields(line[3 : 3+end])
+	if len(ranges) != 2 || !strings.HasPrefix(ranges[0], "-") || !strings.HasPrefix(ranges[1], "+") {
+		return fmt.Errorf("malformed hunk header %q", line)
+	}
+
+	h := &Hunk{Section: strings.TrimSpace(line[3+end+3:])}
+	var err error
+	if h.OldStart, h.OldLines, err = parseRange(ranges[0][1:]); err != nil {
+		return fmt.Errorf("malformed hunk header %q: %w", line, err)
+	}
+	if h.NewStart, h.NewLines, err = parseRange(ranges[1][1:]); err != nil {
+		return fmt.Errorf("malformed hunk header %q: %w", line, err)
+	}
+
+	// The first hunk header is not counted, every following one takes a position.
+	if p.hunk != nil {
+		p.position++
+	}
+
+	p.file.Hunks = append(p.file.Hunks, h)
+	p.hunk = h
+	p.oldLeft, p.newLeft = h.OldLines, h.NewLines
+
+	return nil
+}
+
+func (p *parser) parseHunkLine(line string) error {
+	if strings.HasPrefix(line, `\`) {
+		if n := len(p.hunk.Lines); n > 0 {
+			p.hunk.Lines[n-1].NoNewline = true
+		}
+		return nil
+	}
+
+	kind := Context
+	if line != "" {
+		kind = Kind(line[0])
+		line = line[1:]
+	}
+
+	h := p.hunk
+	l := &Line{Kind: kind, Content: line}
+	oldNumber := h.OldStart + h.OldLines - p.oldLeft
+	newNumber := h.NewStart + h.NewLines - p.newLeft
+
+	switch kind {
+	case Context:
+		l.OldNumber, l.NewNumber = oldNumber, newNumber
+		p.oldLeft--
+		p.newLeft--
+	case Removed:
+		l.OldNumber = oldNumber
+		p.oldLeft--
+	case Added:
+		l.NewNumber = newNumber
+		p.newLeft--
+	default:
+		return fmt.Errorf("unexpected line %q in hunk", string(kind)+line)
+	}
+
+	if p.oldLeft < 0 || p.newLeft < 0 {
+		return fmt.Errorf("hunk is longer than its header")
+	}
+
+	p.position++
+	l.Position = p.position
+	h.Lines = append(h.Lines, l)
+
+	return nil
+}
+
+// parseRange parses the "start[,lines]" of a hunk header. The number of lines defaults to one.
+func parseRange(s string) (start, lines int, err error) {
+	startStr, linesStr, found := strings.Cut(s, ",")
+	if start, err = strconv.Atoi(startStr); err != nil {
+		return 0, 0, err
+	}
+
+	lines = 1
+	if found {
+		if lines, err = strconv.Atoi(linesStr); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return start, lines, nil
+}
+
+// splitGitPaths splits the "a/old b/new" of a "diff --git" line. Paths may contain spaces, so when
+// both halves name the same file it is split in the middle.
+func splitGitPaths(s string) (oldPath, newPath string) {
+	if strings.HasPrefix(s, `"`) {
+		if end := closingQuote(s); end > 0 {
+			oldPath = unquote(s[:end+1])
+			newPath = unquote(strings.TrimSpace(s[end+1:]))
+			return stripPrefix(oldPath, "a/"), stripPrefix(newPath, "b/")
+		}
+	}
+
+	if n := len(s); n%2 == 1 {
+		if half := n / 2; s[half] == ' ' && strings.HasPrefix(s, "a/") && s[half+1:half+3] == "b/" && s[2:half] == s[half+3:] {
+			return s[2:half], s[half+3:]
+		}
+	}
+
+	if i := strings.Index(s, " b/"); i >= 0 {
+		return stripPrefix(s[:i], "a/"), unquote(s[i+3:])
+	}
+
+	return s, s
+}
+
+// closingQuote returns the index of the quote closing the quoted string s starts with, or -1.
+func closingQuote(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+
+	return -1
+}
+
+// unquote decodes a path that git quoted because it contains special characters.
+func unquote(s string) string {
+	if strings.HasPrefix(s, `"`) {
+		if u, err := strconv.Unquote(s); err == nil {
+			return u
+		}
+	}
+
+	// Paths with spaces are followed by a tab in "---" and "+++" lines.
+	return strings.TrimSuffix(s, "\t")
+}
+
+func stripPrefix(path, prefix string) string {
+	if path == "/dev/null" {
+		return path
+	}
+
+	return strings.TrimPrefix(path, prefix)
+}
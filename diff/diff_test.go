@@ -0,0 +1,203 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/davidji99/bitbucket-go/bitbucket"
+	"github.com/stretchr/testify/assert"
+)
+
+const sampleDiff = `diff --git a/main.go b/main.go
+index 83db48f..bf269f4 100644
+--- a/main.go
++++ b/main.go
+@@ -1,3 +1,5 @@ package main
+ package main
+ 
+-import "fmt"
++import (
++	"fmt"
++)
+@@ -10,3 +11,3 @@ func main() {
+ 	a := 1
+-	fmt.Println(a)
++	fmt.Println(a + 1)
+ }
+\ No newline at end of file
+diff --git a/old name.txt b/new name.txt
+similarity index 90%
+rename from old name.txt
+rename to new name.txt
+index 1111111..2222222 100644
+--- a/old name.txt
++++ b/new name.txt
+@@ -1 +1 @@
+-hello
++hello world
+diff --git a/run.sh b/run.sh
+old mode 100644
+new mode 100755
+diff --git a/logo.png b/logo.png
+new file mode 100644
+index 0000000..3333333
+Binary files /dev/null and b/logo.png differ
+diff --git a/gone.txt b/gone.txt
+deleted file mode 100644
+index 4444444..0000000
+--- a/gone.txt
++++ /dev/null
+@@ -1,2 +0,0 @@
+-a
+--- b
+`
+
+func TestParse(t *testing.T) {
+	files, err := Parse(strings.NewReader(sampleDiff))
+	assert.Nil(t, err)
+	assert.Len(t, files, 5)
+
+	main := files[0]
+	assert.Equal(t, "main.go", main.OldPath)
+	assert.Equal(t, "main.go", main.NewPath)
+	assert.Equal(t, StatusModified, main.Status)
+	assert.Equal(t, "100644", main.NewMode)
+	assert.False(t, main.ModeChanged())
+	assert.Len(t, main.Hunks, 2)
+
+	h := main.Hunks[0]
+	assert.Equal(t, 1, h.OldStart)
+	assert.Equal(t, 3, h.OldLines)
+	assert.Equal(t, 1, h.NewStart)
+	assert.Equal(t, 5, h.NewLines)
+	assert.Equal(t, "package main", h.Section)
+	assert.Len(t, h.Lines, 6)
+	assert.Equal(t, &Line{Kind: Context, Content: "", OldNumber: 2, NewNumber: 2, Position: 2}, h.Lines[1])
+	assert.Equal(t, &Line{Kind: Removed, Content: `import "fmt"`, OldNumber: 3, Position: 3}, h.Lines[2])
+	assert.Equal(t, &Line{Kind: Added, Content: ")", NewNumber: 5, Position: 6}, h.Lines[5])
+
+	h = main.Hunks[1]
+	assert.Equal(t, "func main() {", h.Section)
+	assert.Equal(t, &Line{Kind: Added, Content: "\tfmt.Println(a + 1)", NewNumber: 12, Position: 10}, h.Lines[2])
+	assert.True(t, h.Lines[3].NoNewline)
+
+	added, removed := main.Stats()
+	assert.Equal(t, 4, added)
+	assert.Equal(t, 2, removed)
+
+	renamed := files[1]
+	assert.Equal(t, StatusRenamed, renamed.Status)
+	assert.Equal(t, "old name.txt", renamed.OldPath)
+	assert.Equal(t, "new name.txt", renamed.NewPath)
+	assert.Equal(t, 90, renamed.Similarity)
+
+	mode := files[2]
+	assert.Equal(t, "run.sh", mode.Path())
+	assert.True(t, mode.ModeChanged())
+	assert.Empty(t, mode.Hunks)
+
+	binary := files[3]
+	assert.Equal(t, StatusAdded, binary.Status)
+	assert.Equal(t, "", binary.OldPath)
+	assert.Equal(t, "logo.png", binary.NewPath)
+	assert.True(t, binary.Binary)
+
+	gone := files[4]
+	assert.Equal(t, StatusRemoved, gone.Status)
+	assert.Equal(t, "gone.txt", gone.Path())
+	assert.Equal(t, "", gone.NewPath)
+	assert.Equal(t, "-- b", gone.Hunks[0].Lines[1].Content)
+}
+
+func TestParse_Patch(t *testing.T) {
+	patch := `From 0123456789abcdef Mon Sep 17 00:00:00 2001
+From: Jane Doe <jane@example.com>
+Subject: [PATCH] Update readme
+
+---
+ README.md | 2 +-
+ 1 file changed, 1 insertion(+), 1 deletion(-)
+
+diff --git a/README.md b/README.md
+index 1111111..2222222 100644
+--- a/README.md
++++ b/README.md
+@@ -1 +1 @@
+-old
++new
+-- 
+2.39.0
+`
+	files, err := Parse(strings.NewReader(patch))
+	assert.Nil(t, err)
+	assert.Len(t, files, 1)
+	assert.Equal(t, "README.md", files[0].Path())
+	assert.Len(t, files[0].Hunks[0].Lines, 2)
+}
+
+func TestParse_QuotedPaths(t *testing.T) {
+	files, err := Parse(strings.NewReader(`diff --git "a/caf\303\251.txt" "b/caf\303\251.txt"
+new file mode 100644
+--- /dev/null
++++ "b/caf\303\251.txt"
+@@ -0,0 +1 @@
++bonjour
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, "café.txt", files[0].NewPath)
+	assert.Equal(t, 1, files[0].Hunks[0].Lines[0].NewNumber)
+}
+
+func TestParse_Malformed(t *testing.T) {
+	_, err := Parse(strings.NewReader("diff --git a/x b/x\n@@ -1,2 +1,2 @@\n-a\n"))
+	assert.EqualError(t, err, "diff: line 3: unexpected end of hunk")
+
+	_, err = Parse(strings.NewReader("diff --git a/x b/x\n@@ -a +1 @@\n"))
+	assert.NotNil(t, err)
+}
+
+func TestFile_Inline(t *testing.T) {
+	files, err := Parse(strings.NewReader(sampleDiff))
+	assert.Nil(t, err)
+	main := files[0]
+
+	pos, ok := main.Position(New, 12)
+	assert.True(t, ok)
+	assert.Equal(t, 10, pos)
+
+	_, ok = main.Position(New, 8)
+	assert.False(t, ok)
+
+	inline, ok := main.Inline(New, 4)
+	assert.True(t, ok)
+	assert.Equal(t, "main.go", inline.GetPath())
+	assert.Equal(t, int64(4), inline.GetTo())
+	assert.Nil(t, inline.From)
+
+	inline, ok = main.Inline(Old, 3)
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), inline.GetFrom())
+	assert.Nil(t, inline.To)
+
+	inline, ok = main.Inline(Old, 10)
+	assert.True(t, ok)
+	assert.Equal(t, int64(11), inline.GetTo())
+}
+
+func TestMatch(t *testing.T) {
+	files, err := Parse(strings.NewReader(sampleDiff))
+	assert.Nil(t, err)
+
+	stats := []*bitbucket.Diff{
+		{Old: &bitbucket.CodeFile{Path: ptr("main.go")}, New: &bitbucket.CodeFile{Path: ptr("main.go")}},
+		{Old: &bitbucket.CodeFile{Path: ptr("old name.txt")}, New: &bitbucket.CodeFile{Path: ptr("new name.txt")}},
+		{New: &bitbucket.CodeFile{Path: ptr("logo.png")}},
+		{Old: &bitbucket.CodeFile{Path: ptr("gone.txt")}},
+	}
+
+	matches := Match(files, stats)
+	assert.Len(t, matches, 4)
+	assert.Equal(t, stats[1], matches[files[1]])
+	assert.Equal(t, stats[3], matches[files[4]])
+	assert.Nil(t, files[2].Stat(stats))
+}
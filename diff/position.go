@@ -0,0 +1,92 @@
+package diff
+
+import (
+	"github.com/davidji99/bitbucket-go/bitbucket"
+)
+
+// Side selects which version of a file a line number refers to.
+type Side int
+
+const (
+	// Old refers to the line numbers of the file before the change.
+	Old Side = iota
+
+	// New refers to the line numbers of the file after the change.
+	New
+)
+
+// FindLine returns the line of the diff that has the given number on the given side, or nil when
+// the line is not part of any hunk.
+func (f *File) FindLine(side Side, number int) *Line {
+	for _, h := range f.Hunks {
+		for _, l := range h.Lines {
+			if side == Old && l.OldNumber == number || side == New && l.NewNumber == number {
+				return l
+			}
+		}
+	}
+
+	return nil
+}
+
+// Position returns the position in the diff of the line with the given number on the given side.
+// It reports false when the line is not part of any hunk.
+func (f *File) Position(side Side, number int) (int, bool) {
+	l := f.FindLine(side, number)
+	if l == nil {
+		return 0, false
+	}
+
+	return l.Position, true
+}
+
+// Inline returns the inline location of a pull request comment on the line with the given number
+// on the given side, for use in bitbucket.PRCommentRequest.
+//
+// Bitbucket anchors comments on added and unchanged lines to the new file ("to") and comments on removed
+// lines to the old file ("from"). Inline reports false when the line is not part of any hunk, as
+// Bitbucket cannot show a comment there inline.
+func (f *File) Inline(side Side, number int) (*bitbucket.PRCommentInline, bool) {
+	l := f.FindLine(side, number)
+	if l == nil {
+		return nil, false
+	}
+
+	inline := &bitbucket.PRCommentInline{Path: ptr(f.Path())}
+	if l.Kind == Removed {
+		inline.From = ptr(int64(l.OldNumber))
+	} else {
+		inline.To = ptr(int64(l.NewNumber))
+	}
+
+	return inline, true
+}
+
+// Stat returns the entry of a diffstat, as returned by DiffService.Get or PullRequestsService.GetDiff,
+// that describes the file, or nil if there is none.
+func (f *File) Stat(stats []*bitbucket.Diff) *bitbucket.Diff {
+	for _, s := range stats {
+		if s.GetOld().GetPath() == f.OldPath && s.GetNew().GetPath() == f.NewPath {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// Match pairs the files of a parsed diff with the entries of the diffstat of the same changes.
+// Files without an entry, such as those excluded from the diffstat by its options, are left out.
+func Match(files []*File, stats []*bitbucket.Diff) map[*File]*bitbucket.Diff {
+	matches := make(map[*File]*bitbucket.Diff, len(files))
+	for _, f := range files {
+		if s := f.Stat(stats); s != nil {
+			matches[f] = s
+		}
+	}
+
+	return matches
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,29 @@
+// Package tests runs the client against the in-memory fake Bitbucket API of the bbtest package,
+// so that it needs neither credentials nor network access.
+package tests
+
+import (
+	"testing"
+
+	"github.com/davidji99/bitbucket-go/bbtest"
+	"github.com/davidji99/bitbucket-go/bitbucket"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	owner    = "owner"
+	repoSlug = "repo"
+)
+
+// newTestServer starts a bbtest.Server holding an owner/repo repository and returns it along with a client.
+func newTestServer(t *testing.T) (*bbtest.Server, *bitbucket.Client) {
+	server := bbtest.NewServer()
+	t.Cleanup(server.Close)
+
+	server.AddRepository(owner, repoSlug)
+
+	client, err := server.Client()
+	assert.Nil(t, err)
+
+	return server, client
+}
@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/davidji99/bitbucket-go/bitbucket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssues(t *testing.T) {
+	_, client := newTestServer(t)
+	ctx := context.Background()
+
+	title, kind := "Crash on start", "bug"
+	issue, _, err := client.Issues.Create(ctx, owner, repoSlug, &bitbucket.IssueRequest{Title: &title, Kind: &kind})
+	assert.Nil(t, err)
+	assert.Equal(t, "new", issue.GetState())
+
+	priority := "critical"
+	updated, _, err := client.Issues.Update(ctx, owner, repoSlug, issue.GetID(), &bitbucket.IssueRequest{Priority: &priority})
+	assert.Nil(t, err)
+	assert.Equal(t, "critical", updated.GetPriority())
+
+	raw := "Reproduced on main"
+	comment, _, err := client.Issues.CreateComment(ctx, owner, repoSlug, issue.GetID(),
+		&bitbucket.IssueCommentRequest{Content: &bitbucket.Content{Raw: &raw}})
+	assert.Nil(t, err)
+	assert.Equal(t, issue.GetID(), comment.GetIssue().GetID())
+
+	comments, _, err := client.Issues.ListComments(ctx, owner, repoSlug, issue.GetID())
+	assert.Nil(t, err)
+	assert.Len(t, comments.Values, 1)
+
+	issues, _, err := client.Issues.List(ctx, owner, repoSlug)
+	assert.Nil(t, err)
+	assert.Len(t, issues.Values, 1)
+
+	_, _, err = client.Issues.Create(ctx, owner, repoSlug, &bitbucket.IssueRequest{})
+	assert.True(t, bitbucket.IsBadRequest(err))
+}
@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/davidji99/bitbucket-go/bitbucket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullRequests(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	server.AddBranch(owner, repoSlug, "feature")
+
+	title, feature := "Add feature", "feature"
+	pr, _, err := client.PullRequests.Create(ctx, owner, repoSlug, &bitbucket.PRRequest{
+		Title:  &title,
+		Source: &bitbucket.PRRequestSourceOpts{Branch: &bitbucket.Branch{Name: &feature}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "OPEN", pr.GetState())
+
+	description := "Adds the feature"
+	updated, _, err := client.PullRequests.Update(ctx, owner, repoSlug, pr.GetID(), &bitbucket.PRRequest{Description: &description})
+	assert.Nil(t, err)
+	assert.Equal(t, "Adds the feature", updated.GetDescription())
+
+	raw := "Please add tests"
+	_, _, err = client.PullRequests.CreateComment(ctx, owner, repoSlug, pr.GetID(),
+		&bitbucket.PRCommentRequest{Content: &bitbucket.Content{Raw: &raw}})
+	assert.Nil(t, err)
+
+	comments, _, err := client.PullRequests.ListComments(ctx, owner, repoSlug, pr.GetID())
+	assert.Nil(t, err)
+	assert.Len(t, comments.Values, 1)
+
+	declined, _, err := client.PullRequests.DeclinePR(ctx, owner, repoSlug, pr.GetID())
+	assert.Nil(t, err)
+	assert.Equal(t, "DECLINED", declined.GetState())
+
+	_, _, err = client.PullRequests.DeclinePR(ctx, owner, repoSlug, pr.GetID())
+	assert.True(t, bitbucket.IsBadRequest(err))
+
+	prs, _, err := client.PullRequests.List(ctx, owner, repoSlug, &bitbucket.PullRequestListOpts{State: []string{"DECLINED"}})
+	assert.Nil(t, err)
+	assert.Len(t, prs.Values, 1)
+}
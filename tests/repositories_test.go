@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/davidji99/bitbucket-go/bitbucket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepositories(t *testing.T) {
+	_, client := newTestServer(t)
+	ctx := context.Background()
+
+	name, description := "other", "A second repository"
+	created, _, err := client.Repositories.Create(ctx, owner, &bitbucket.RepositoryRequest{Name: &name, Description: &description})
+	assert.Nil(t, err)
+	assert.Equal(t, "owner/other", created.GetFullName())
+	assert.Equal(t, "main", created.GetMainBranch().GetName())
+
+	description = "Renamed"
+	updated, _, err := client.Repositories.Update(ctx, owner, "other", &bitbucket.RepositoryRequest{Description: &description})
+	assert.Nil(t, err)
+	assert.Equal(t, "Renamed", updated.GetDescription())
+
+	repos, _, err := client.Repositories.List(ctx, owner)
+	assert.Nil(t, err)
+	assert.Len(t, repos.Values, 2)
+
+	_, err = client.Repositories.Delete(ctx, owner, "other", nil)
+	assert.Nil(t, err)
+
+	_, _, err = client.Repositories.Get(ctx, owner, "other")
+	assert.True(t, bitbucket.IsNotFound(err))
+}
+
+func TestRefs(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	hash := server.AddBranch(owner, repoSlug, "feature/login")
+
+	tagName := "v1.0.0"
+	tag := &bitbucket.RefRequest{Name: &tagName}
+	tag.Target.Hash = &hash
+	created, _, err := client.Refs.CreateTag(ctx, owner, repoSlug, tag)
+	assert.Nil(t, err)
+	assert.Equal(t, hash, created.GetTarget().GetHash())
+
+	branch, _, err := client.Refs.GetBranch(ctx, owner, repoSlug, "feature/login")
+	assert.Nil(t, err)
+	assert.Equal(t, hash, branch.GetTarget().GetHash())
+
+	_, err = client.Refs.DeleteBranch(ctx, owner, repoSlug, "feature/login")
+	assert.Nil(t, err)
+
+	branches, _, err := client.Refs.ListBranches(ctx, owner, repoSlug)
+	assert.Nil(t, err)
+	assert.Len(t, branches.Values, 1)
+
+	_, _, err = client.Refs.CreateTag(ctx, owner, repoSlug, tag)
+	assert.True(t, bitbucket.IsBadRequest(err))
+}
+
+func TestHooksAndStatuses(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	hookURL, event := "https://example.com/hook", "repo:push"
+	hook, _, err := client.Repositories.CreateHook(ctx, owner, repoSlug,
+		&bitbucket.RepositoryHookRequest{URL: &hookURL, Events: []*string{&event}})
+	assert.Nil(t, err)
+
+	active := false
+	updated, _, err := client.Repositories.UpdateHook(ctx, owner, repoSlug, hook.GetUUID(), &bitbucket.RepositoryHookRequest{Active: &active})
+	assert.Nil(t, err)
+	assert.False(t, updated.GetActive())
+
+	_, err = client.Repositories.DeleteHook(ctx, owner, repoSlug, hook.GetUUID())
+	assert.Nil(t, err)
+
+	hooks, _, err := client.Repositories.ListHooks(ctx, owner, repoSlug)
+	assert.Nil(t, err)
+	assert.Empty(t, hooks.Values)
+
+	hash := server.AddBranch(owner, repoSlug, "feature")
+	state, key := "INPROGRESS", "ci"
+	_, _, err = client.Commit.CreateStatus(ctx, owner, repoSlug, hash, &bitbucket.CommitStatusRequest{State: &state, Key: &key})
+	assert.Nil(t, err)
+
+	status, _, err := client.Commit.GetStatusByBuild(ctx, owner, repoSlug, hash, "ci")
+	assert.Nil(t, err)
+	assert.Equal(t, "INPROGRESS", status.GetState())
+
+	statuses, _, err := client.Commit.ListStatuses(ctx, owner, repoSlug, hash)
+	assert.Nil(t, err)
+	assert.Len(t, statuses.Values, 1)
+}
@@ -0,0 +1,119 @@
+package webhook
+
+import (
+	"github.com/davidji99/bitbucket-go/bitbucket"
+	"time"
+)
+
+// PushEvent represents a repo:push payload, sent when branches or tags are pushed, created or deleted.
+type PushEvent struct {
+	Actor      *bitbucket.User       `json:"actor,omitempty"`
+	Repository *bitbucket.Repository `json:"repository,omitempty"`
+	Push       *Push                 `json:"push,omitempty"`
+}
+
+// Push represents the "push" object in a repo:push payload.
+type Push struct {
+	Changes []*PushChange `json:"changes,omitempty"`
+}
+
+// PushChange represents the change of a single branch or tag in a push.
+//
+// Old is nil when the ref was created and New is nil when the ref was deleted.
+// Commits holds at most five commits; Truncated is set when the push contained more.
+type PushChange struct {
+	Old       *bitbucket.Ref      `json:"old,omitempty"`
+	New       *bitbucket.Ref      `json:"new,omitempty"`
+	Created   *bool               `json:"created,omitempty"`
+	Closed    *bool               `json:"closed,omitempty"`
+	Forced    *bool               `json:"forced,omitempty"`
+	Truncated *bool               `json:"truncated,omitempty"`
+	Commits   []*bitbucket.Commit `json:"commits,omitempty"`
+	Links     *PushChangeLinks    `json:"links,omitempty"`
+}
+
+// PushChangeLinks represents the "links" object in a push change.
+type PushChangeLinks struct {
+	HTML    *bitbucket.Link `json:"html,omitempty"`
+	Diff    *bitbucket.Link `json:"diff,omitempty"`
+	Commits *bitbucket.Link `json:"commits,omitempty"`
+}
+
+// CommitStatusEvent represents a repo:commit_status_created or repo:commit_status_updated payload.
+type CommitStatusEvent struct {
+	Actor        *bitbucket.User         `json:"actor,omitempty"`
+	Repository   *bitbucket.Repository   `json:"repository,omitempty"`
+	CommitStatus *bitbucket.CommitStatus `json:"commit_status,omitempty"`
+}
+
+// PullRequestEvent represents a pullrequest:created, pullrequest:updated, pullrequest:fulfilled
+// or pullrequest:rejected payload.
+type PullRequestEvent struct {
+	Actor       *bitbucket.User        `json:"actor,omitempty"`
+	Repository  *bitbucket.Repository  `json:"repository,omitempty"`
+	PullRequest *bitbucket.PullRequest `json:"pullrequest,omitempty"`
+}
+
+// PullRequestApprovalEvent represents a pullrequest:approved or pullrequest:unapproved payload.
+type PullRequestApprovalEvent struct {
+	Actor       *bitbucket.User        `json:"actor,omitempty"`
+	Repository  *bitbucket.Repository  `json:"repository,omitempty"`
+	PullRequest *bitbucket.PullRequest `json:"pullrequest,omitempty"`
+	Approval    *Approval              `json:"approval,omitempty"`
+}
+
+// PullRequestChangesRequestEvent represents a pullrequest:changes_request_created
+// or pullrequest:changes_request_removed payload.
+type PullRequestChangesRequestEvent struct {
+	Actor          *bitbucket.User        `json:"actor,omitempty"`
+	Repository     *bitbucket.Repository  `json:"repository,omitempty"`
+	PullRequest    *bitbucket.PullRequest `json:"pullrequest,omitempty"`
+	ChangesRequest *Approval              `json:"changes_request,omitempty"`
+}
+
+// Approval represents who approved, or requested changes to, a pull request and when.
+type Approval struct {
+	Date *time.Time      `json:"date,omitempty"`
+	User *bitbucket.User `json:"user,omitempty"`
+}
+
+// PullRequestCommentEvent represents a pullrequest:comment_created, pullrequest:comment_updated,
+// pullrequest:comment_deleted, pullrequest:comment_resolved or pullrequest:comment_reopened payload.
+type PullRequestCommentEvent struct {
+	Actor       *bitbucket.User        `json:"actor,omitempty"`
+	Repository  *bitbucket.Repository  `json:"repository,omitempty"`
+	PullRequest *bitbucket.PullRequest `json:"pullrequest,omitempty"`
+	Comment     *bitbucket.PRComment   `json:"comment,omitempty"`
+}
+
+// IssueEvent represents an issue:created payload.
+type IssueEvent struct {
+	Actor      *bitbucket.User       `json:"actor,omitempty"`
+	Repository *bitbucket.Repository `json:"repository,omitempty"`
+	Issue      *bitbucket.Issue      `json:"issue,omitempty"`
+}
+
+// IssueUpdatedEvent represents an issue:updated payload.
+//
+// Changes is keyed by the name of each changed field, such as "status", "title" or "assignee".
+type IssueUpdatedEvent struct {
+	Actor      *bitbucket.User              `json:"actor,omitempty"`
+	Repository *bitbucket.Repository        `json:"repository,omitempty"`
+	Issue      *bitbucket.Issue             `json:"issue,omitempty"`
+	Comment    *bitbucket.IssueComment      `json:"comment,omitempty"`
+	Changes    map[string]*IssueFieldChange `json:"changes,omitempty"`
+}
+
+// IssueFieldChange represents the old and new value of a changed issue field.
+type IssueFieldChange struct {
+	Old *string `json:"old,omitempty"`
+	New *string `json:"new,omitempty"`
+}
+
+// IssueCommentEvent represents an issue:comment_created payload.
+type IssueCommentEvent struct {
+	Actor      *bitbucket.User         `json:"actor,omitempty"`
+	Repository *bitbucket.Repository   `json:"repository,omitempty"`
+	Issue      *bitbucket.Issue        `json:"issue,omitempty"`
+	Comment    *bitbucket.IssueComment `json:"comment,omitempty"`
+}
@@ -0,0 +1,390 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/davidji99/bitbucket-go/bitbucket"
+)
+
+const (
+	// SignatureHeader is the header holding the HMAC of the request body when the webhook has a secret.
+	SignatureHeader = "X-Hub-Signature"
+
+	// DefaultReplayWindow is how long a Handler remembers request UUIDs to drop replays.
+	DefaultReplayWindow = time.Hour
+
+	// DefaultMaxBodyBytes is the largest request body a Handler reads.
+	DefaultMaxBodyBytes = 10 << 20
+)
+
+var (
+	errInvalidSignature = errors.New("webhook: invalid " + SignatureHeader + " header")
+	errUnknownHook      = errors.New("webhook: unknown " + HookUUIDHeader + " header")
+	errMissingRequestID = errors.New("webhook: missing " + RequestUUIDHeader + " header")
+)
+
+// Delivery represents the metadata Bitbucket sends in the headers of a webhook request.
+type Delivery struct {
+	EventKey    string
+	HookUUID    string
+	RequestUUID string
+	Attempt     int
+}
+
+type deliveryKey struct{}
+
+// DeliveryFromContext returns the delivery of the request being handled.
+// It is available in the context passed to the functions registered on a Handler.
+func DeliveryFromContext(ctx context.Context) (Delivery, bool) {
+	d, ok := ctx.Value(deliveryKey{}).(Delivery)
+	return d, ok
+}
+
+// HandlerOption is a functional option for configuring a Handler.
+type HandlerOption func(*Handler)
+
+// Secret sets the webhook secret used to verify the X-Hub-Signature header.
+//
+// Requests without a valid signature are rejected. Without a secret, signatures are not checked.
+func Secret(secret string) HandlerOption {
+	return func(h *Handler) {
+		h.secret = []byte(secret)
+	}
+}
+
+// ReplayWindow sets how long request UUIDs are remembered to drop replayed requests.
+func ReplayWindow(d time.Duration) HandlerOption {
+	return func(h *Handler) {
+		h.replayWindow = d
+	}
+}
+
+// MaxBodyBytes sets the largest request body the Handler reads.
+func MaxBodyBytes(n int64) HandlerOption {
+	return func(h *Handler) {
+		h.maxBodyBytes = n
+	}
+}
+
+// Handler is an http.Handler that verifies, decodes and routes Bitbucket webhook requests
+// to the functions registered for each event key.
+//
+// A request is rejected when:
+//   - its X-Hub-Signature does not match the body and the Handler has a secret,
+//   - its X-Hook-UUID is not one of the allowed hooks, when hooks have been allowed,
+//   - its body cannot be decoded for its X-Event-Key.
+//
+// Requests for event keys without a registered function are acknowledged and dropped, as are requests whose
+// X-Request-UUID was already delivered within the replay window, so that Bitbucket does not retry them.
+type Handler struct {
+	secret       []byte
+	replayWindow time.Duration
+	maxBodyBytes int64
+	now          func() time.Time
+
+	mu       sync.RWMutex
+	handlers map[string][]func(context.Context, interface{})
+	hooks    map[string]bool
+
+	seenMu    sync.Mutex
+	seen      map[string]time.Time
+	lastPrune time.Time
+}
+
+// NewHandler returns a Handler configured with opts.
+func NewHandler(opts ...HandlerOption) *Handler {
+	h := &Handler{
+		replayWindow: DefaultReplayWindow,
+		maxBodyBytes: DefaultMaxBodyBytes,
+		now:          time.Now,
+		handlers:     make(map[string][]func(context.Context, interface{})),
+		hooks:        make(map[string]bool),
+		seen:         make(map[string]time.Time),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// AllowHook restricts the Handler to requests sent by the webhooks with the given UUIDs.
+//
+// Until a hook is allowed, requests from any hook are accepted.
+func (h *Handler) AllowHook(uuids ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, uuid := range uuids {
+		h.hooks[normalizeUUID(uuid)] = true
+	}
+}
+
+// AllowRepositoryHooks allows the repository webhooks returned by RepositoriesService.ListHooks or CreateHook.
+func (h *Handler) AllowRepositoryHooks(hooks ...*bitbucket.RepositoryHook) {
+	for _, hook := range hooks {
+		h.AllowHook(hook.GetUUID())
+	}
+}
+
+// AllowUserHooks allows the user webhooks returned by UsersService.ListHooks.
+func (h *Handler) AllowUserHooks(hooks ...*bitbucket.UserHook) {
+	for _, hook := range hooks {
+		h.AllowHook(hook.GetUUID())
+	}
+}
+
+// AllowWorkspaceHooks allows the workspace webhooks returned by WorkspacesService.ListHooks or CreateHook.
+func (h *Handler) AllowWorkspaceHooks(hooks ...*bitbucket.WorkspaceHook) {
+	for _, hook := range hooks {
+		h.AllowHook(hook.GetUUID())
+	}
+}
+
+// On registers fn for the given event key. The event passed to fn is the value Parse returns for the key.
+func (h *Handler) On(eventKey string, fn func(ctx context.Context, event interface{})) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handlers[eventKey] = append(h.handlers[eventKey], fn)
+}
+
+func on[T any](h *Handler, eventKey string, fn func(context.Context, *T)) {
+	h.On(eventKey, func(ctx context.Context, event interface{}) {
+		fn(ctx, event.(*T))
+	})
+}
+
+// OnPush registers fn for repo:push events.
+func (h *Handler) OnPush(fn func(ctx context.Context, event *PushEvent)) {
+	on(h, EventRepoPush, fn)
+}
+
+// OnCommitStatusCreated registers fn for repo:commit_status_created events.
+func (h *Handler) OnCommitStatusCreated(fn func(ctx context.Context, event *CommitStatusEvent)) {
+	on(h, EventRepoCommitStatusCreated, fn)
+}
+
+// OnCommitStatusUpdated registers fn for repo:commit_status_updated events.
+func (h *Handler) OnCommitStatusUpdated(fn func(ctx context.Context, event *CommitStatusEvent)) {
+	on(h, EventRepoCommitStatusUpdated, fn)
+}
+
+// OnPullRequestCreated registers fn for pullrequest:created events.
+func (h *Handler) OnPullRequestCreated(fn func(ctx context.Context, event *PullRequestEvent)) {
+	on(h, EventPullRequestCreated, fn)
+}
+
+// OnPullRequestUpdated registers fn for pullrequest:updated events.
+func (h *Handler) OnPullRequestUpdated(fn func(ctx context.Context, event *PullRequestEvent)) {
+	on(h, EventPullRequestUpdated, fn)
+}
+
+// OnPullRequestApproved registers fn for pullrequest:approved events.
+func (h *Handler) OnPullRequestApproved(fn func(ctx context.Context, event *PullRequestApprovalEvent)) {
+	on(h, EventPullRequestApproved, fn)
+}
+
+// OnPullRequestUnapproved registers fn for pullrequest:unapproved events.
+func (h *Handler) OnPullRequestUnapproved(fn func(ctx context.Context, event *PullRequestApprovalEvent)) {
+	on(h, EventPullRequestUnapproved, fn)
+}
+
+// OnPullRequestChangesRequestCreated registers fn for pullrequest:changes_request_created events.
+func (h *Handler) OnPullRequestChangesRequestCreated(fn func(ctx context.Context, event *PullRequestChangesRequestEvent)) {
+	on(h, EventPullRequestChangesRequestCreated, fn)
+}
+
+// OnPullRequestChangesRequestRemoved registers fn for pullrequest:changes_request_removed events.
+func (h *Handler) OnPullRequestChangesRequestRemoved(fn func(ctx context.Context, event *PullRequestChangesRequestEvent)) {
+	on(h, EventPullRequestChangesRequestRemoved, fn)
+}
+
+// OnPullRequestFulfilled registers fn for pullrequest:fulfilled events, sent when a pull request is merged.
+func (h *Handler) OnPullRequestFulfilled(fn func(ctx context.Context, event *PullRequestEvent)) {
+	on(h, EventPullRequestFulfilled, fn)
+}
+
+// OnPullRequestRejected registers fn for pullrequest:rejected events, sent when a pull request is declined.
+func (h *Handler) OnPullRequestRejected(fn func(ctx context.Context, event *PullRequestEvent)) {
+	on(h, EventPullRequestRejected, fn)
+}
+
+// OnPullRequestCommentCreated registers fn for pullrequest:comment_created events.
+func (h *Handler) OnPullRequestCommentCreated(fn func(ctx context.Context, event *PullRequestCommentEvent)) {
+	on(h, EventPullRequestCommentCreated, fn)
+}
+
+// OnPullRequestCommentUpdated registers fn for pullrequest:comment_updated events.
+func (h *Handler) OnPullRequestCommentUpdated(fn func(ctx context.Context, event *PullRequestCommentEvent)) {
+	on(h, EventPullRequestCommentUpdated, fn)
+}
+
+// OnPullRequestCommentDeleted registers fn for pullrequest:comment_deleted events.
+func (h *Handler) OnPullRequestCommentDeleted(fn func(ctx context.Context, event *PullRequestCommentEvent)) {
+	on(h, EventPullRequestCommentDeleted, fn)
+}
+
+// OnPullRequestCommentResolved registers fn for pullrequest:comment_resolved events.
+func (h *Handler) OnPullRequestCommentResolved(fn func(ctx context.Context, event *PullRequestCommentEvent)) {
+	on(h, EventPullRequestCommentResolved, fn)
+}
+
+// OnPullRequestCommentReopened registers fn for pullrequest:comment_reopened events.
+func (h *Handler) OnPullRequestCommentReopened(fn func(ctx context.Context, event *PullRequestCommentEvent)) {
+	on(h, EventPullRequestCommentReopened, fn)
+}
+
+// OnIssueCreated registers fn for issue:created events.
+func (h *Handler) OnIssueCreated(fn func(ctx context.Context, event *IssueEvent)) {
+	on(h, EventIssueCreated, fn)
+}
+
+// OnIssueUpdated registers fn for issue:updated events.
+func (h *Handler) OnIssueUpdated(fn func(ctx context.Context, event *IssueUpdatedEvent)) {
+	on(h, EventIssueUpdated, fn)
+}
+
+// OnIssueCommentCreated registers fn for issue:comment_created events.
+func (h *Handler) OnIssueCommentCreated(fn func(ctx context.Context, event *IssueCommentEvent)) {
+	on(h, EventIssueCommentCreated, fn)
+}
+
+// ServeHTTP verifies and decodes a webhook request, then calls the functions registered for its event key.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	delivery := Delivery{
+		EventKey:    r.Header.Get(EventKeyHeader),
+		HookUUID:    r.Header.Get(HookUUIDHeader),
+		RequestUUID: r.Header.Get(RequestUUIDHeader),
+	}
+	delivery.Attempt, _ = strconv.Atoi(r.Header.Get(AttemptNumberHeader))
+
+	if delivery.EventKey == "" {
+		http.Error(w, ErrMissingEventKey.Error(), http.StatusBadRequest)
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodyBytes))
+	if err != nil {
+		http.Error(w, "webhook: reading request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !h.validSignature(r.Header.Get(SignatureHeader), payload) {
+		http.Error(w, errInvalidSignature.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	if !h.allowedHook(delivery.HookUUID) {
+		http.Error(w, errUnknownHook.Error(), http.StatusForbidden)
+		return
+	}
+
+	if delivery.RequestUUID == "" {
+		http.Error(w, errMissingRequestID.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.mu.RLock()
+	fns := h.handlers[delivery.EventKey]
+	h.mu.RUnlock()
+
+	if len(fns) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	event, err := Parse(delivery.EventKey, payload)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !h.markDelivered(delivery.RequestUUID) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), deliveryKey{}, delivery)
+	for _, fn := range fns {
+		fn(ctx, event)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validSignature reports whether header holds the HMAC of payload, formatted as "<algorithm>=<hex digest>".
+// It always succeeds when the Handler has no secret.
+func (h *Handler) validSignature(header string, payload []byte) bool {
+	if len(h.secret) == 0 {
+		return true
+	}
+
+	algorithm, digest, found := strings.Cut(header, "=")
+	if !found || algorithm != "sha256" {
+		return false
+	}
+
+	got, err := hex.DecodeString(digest)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write(payload)
+
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+func (h *Handler) allowedHook(uuid string) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.hooks) == 0 || h.hooks[normalizeUUID(uuid)]
+}
+
+// markDelivered records a request UUID and reports whether it had not been seen within the replay window.
+func (h *Handler) markDelivered(requestUUID string) bool {
+	h.seenMu.Lock()
+	defer h.seenMu.Unlock()
+
+	now := h.now()
+	if now.Sub(h.lastPrune) > h.replayWindow/10 {
+		for id, at := range h.seen {
+			if now.Sub(at) > h.replayWindow {
+				delete(h.seen, id)
+			}
+		}
+		h.lastPrune = now
+	}
+
+	if at, ok := h.seen[requestUUID]; ok && now.Sub(at) <= h.replayWindow {
+		return false
+	}
+
+	h.seen[requestUUID] = now
+
+	return true
+}
+
+// normalizeUUID strips the braces Bitbucket puts around UUIDs in API responses but not in webhook headers.
+func normalizeUUID(uuid string) string {
+	return strings.ToLower(strings.Trim(uuid, "{}"))
+}
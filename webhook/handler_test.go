@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/davidji99/bitbucket-go/bitbucket"
+	"github.com/stretchr/testify/assert"
+)
+
+const pullRequestPayload = `{"pullrequest": {"id": 9, "title": "Add feature"}, "repository": {"full_name": "owner/repo"}}`
+
+func sign(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func deliver(t *testing.T, server *httptest.Server, eventKey, requestUUID, signature, payload string) int {
+	req, err := http.NewRequest("POST", server.URL, bytes.NewBufferString(payload))
+	assert.Nil(t, err)
+	req.Header.Set(EventKeyHeader, eventKey)
+	req.Header.Set(HookUUIDHeader, "{hook-1}")
+	req.Header.Set(RequestUUIDHeader, requestUUID)
+	req.Header.Set(AttemptNumberHeader, "1")
+	if signature != "" {
+		req.Header.Set(SignatureHeader, signature)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	resp.Body.Close()
+
+	return resp.StatusCode
+}
+
+func TestHandler_RoutesVerifiedEvents(t *testing.T) {
+	handler := NewHandler(Secret("s3cret"))
+
+	var received *PullRequestEvent
+	var delivery Delivery
+	calls := 0
+	handler.OnPullRequestCreated(func(ctx context.Context, e *PullRequestEvent) {
+		received = e
+		delivery, _ = DeliveryFromContext(ctx)
+		calls++
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	status := deliver(t, server, EventPullRequestCreated, "req-1", sign("s3cret", pullRequestPayload), pullRequestPayload)
+	assert.Equal(t, http.StatusNoContent, status)
+	assert.Equal(t, int64(9), received.PullRequest.GetID())
+	assert.Equal(t, Delivery{EventKey: EventPullRequestCreated, HookUUID: "{hook-1}", RequestUUID: "req-1", Attempt: 1}, delivery)
+
+	// A replayed delivery is acknowledged, so that Bitbucket stops retrying it, but not dispatched again.
+	status = deliver(t, server, EventPullRequestCreated, "req-1", sign("s3cret", pullRequestPayload), pullRequestPayload)
+	assert.Equal(t, http.StatusNoContent, status)
+	assert.Equal(t, 1, calls)
+
+	status = deliver(t, server, EventPullRequestCreated, "req-2", sign("wrong", pullRequestPayload), pullRequestPayload)
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	status = deliver(t, server, EventPullRequestCreated, "req-3", "", pullRequestPayload)
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	status = deliver(t, server, EventPullRequestRejected, "req-4", sign("s3cret", pullRequestPayload), pullRequestPayload)
+	assert.Equal(t, http.StatusNoContent, status)
+}
+
+func TestHandler_AllowedHooks(t *testing.T) {
+	handler := NewHandler()
+	handler.OnPullRequestCreated(func(ctx context.Context, e *PullRequestEvent) {})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	otherHook := "{hook-2}"
+	handler.AllowRepositoryHooks(&bitbucket.RepositoryHook{UUID: &otherHook})
+	assert.Equal(t, http.StatusForbidden, deliver(t, server, EventPullRequestCreated, "req-1", "", pullRequestPayload))
+
+	handler.AllowHook("HOOK-1")
+	assert.Equal(t, http.StatusNoContent, deliver(t, server, EventPullRequestCreated, "req-1", "", pullRequestPayload))
+}
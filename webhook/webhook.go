@@ -0,0 +1,117 @@
+// Package webhook decodes the webhook payloads that Bitbucket sends when repository, pull request and issue events occur.
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	// EventKeyHeader is the header holding the event key of a webhook request.
+	EventKeyHeader = "X-Event-Key"
+
+	// HookUUIDHeader is the header holding the UUID of the webhook that sent a request.
+	HookUUIDHeader = "X-Hook-UUID"
+
+	// RequestUUIDHeader is the header holding the UUID of a webhook request, which is the same across retries.
+	RequestUUIDHeader = "X-Request-UUID"
+
+	// AttemptNumberHeader is the header holding the delivery attempt of a webhook request.
+	AttemptNumberHeader = "X-Attempt-Number"
+)
+
+// Event keys sent by Bitbucket in the X-Event-Key header.
+const (
+	EventRepoPush                = "repo:push"
+	EventRepoCommitStatusCreated = "repo:commit_status_created"
+	EventRepoCommitStatusUpdated = "repo:commit_status_updated"
+
+	EventPullRequestCreated               = "pullrequest:created"
+	EventPullRequestUpdated               = "pullrequest:updated"
+	EventPullRequestApproved              = "pullrequest:approved"
+	EventPullRequestUnapproved            = "pullrequest:unapproved"
+	EventPullRequestChangesRequestCreated = "pullrequest:changes_request_created"
+	EventPullRequestChangesRequestRemoved = "pullrequest:changes_request_removed"
+	EventPullRequestFulfilled             = "pullrequest:fulfilled"
+	EventPullRequestRejected              = "pullrequest:rejected"
+	EventPullRequestCommentCreated        = "pullrequest:comment_created"
+	EventPullRequestCommentUpdated        = "pullrequest:comment_updated"
+	EventPullRequestCommentDeleted        = "pullrequest:comment_deleted"
+	EventPullRequestCommentResolved       = "pullrequest:comment_resolved"
+	EventPullRequestCommentReopened       = "pullrequest:comment_reopened"
+
+	EventIssueCreated        = "issue:created"
+	EventIssueUpdated        = "issue:updated"
+	EventIssueCommentCreated = "issue:comment_created"
+)
+
+// ErrMissingEventKey is returned by ParseRequest when a request has no X-Event-Key header.
+var ErrMissingEventKey = errors.New("webhook: missing " + EventKeyHeader + " header")
+
+// UnknownEventError is returned when a payload carries an event key this package does not decode.
+type UnknownEventError struct {
+	EventKey string
+}
+
+func (e *UnknownEventError) Error() string {
+	return fmt.Sprintf("webhook: unknown event key %q", e.EventKey)
+}
+
+// Parse decodes a webhook payload for the given event key.
+//
+// The returned value is a pointer to one of the event types of this package, such as *PushEvent
+// or *PullRequestEvent. Use a type switch to handle it. An *UnknownEventError is returned for event keys
+// this package does not support.
+func Parse(eventKey string, payload []byte) (interface{}, error) {
+	var event interface{}
+
+	switch eventKey {
+	case EventRepoPush:
+		event = new(PushEvent)
+	case EventRepoCommitStatusCreated, EventRepoCommitStatusUpdated:
+		event = new(CommitStatusEvent)
+	case EventPullRequestCreated, EventPullRequestUpdated, EventPullRequestFulfilled, EventPullRequestRejected:
+		event = new(PullRequestEvent)
+	case EventPullRequestApproved, EventPullRequestUnapproved:
+		event = new(PullRequestApprovalEvent)
+	case EventPullRequestChangesRequestCreated, EventPullRequestChangesRequestRemoved:
+		event = new(PullRequestChangesRequestEvent)
+	case EventPullRequestCommentCreated, EventPullRequestCommentUpdated, EventPullRequestCommentDeleted,
+		EventPullRequestCommentResolved, EventPullRequestCommentReopened:
+		event = new(PullRequestCommentEvent)
+	case EventIssueCreated:
+		event = new(IssueEvent)
+	case EventIssueUpdated:
+		event = new(IssueUpdatedEvent)
+	case EventIssueCommentCreated:
+		event = new(IssueCommentEvent)
+	default:
+		return nil, &UnknownEventError{EventKey: eventKey}
+	}
+
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, fmt.Errorf("webhook: decoding %s payload: %w", eventKey, err)
+	}
+
+	return event, nil
+}
+
+// ParseRequest reads the event key from the X-Event-Key header of r and decodes its body.
+//
+// See Parse for the returned values.
+func ParseRequest(r *http.Request) (interface{}, error) {
+	eventKey := r.Header.Get(EventKeyHeader)
+	if eventKey == "" {
+		return nil, ErrMissingEventKey
+	}
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("webhook: reading request body: %w", err)
+	}
+
+	return Parse(eventKey, payload)
+}
@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const pushPayload = `{
+  "actor": {"display_name": "Jane", "uuid": "{u-1}"},
+  "repository": {"full_name": "owner/repo"},
+  "push": {
+    "changes": [{
+      "old": {"type": "branch", "name": "main", "target": {"hash": "aaa"}},
+      "new": {"type": "branch", "name": "main", "target": {"hash": "bbb"}},
+      "created": false,
+      "forced": false,
+      "truncated": false,
+      "commits": [{"hash": "bbb", "message": "Fix"}],
+      "links": {"diff": {"href": "https://bitbucket.org/owner/repo/branches/compare/bbb..aaa"}}
+    }]
+  }
+}`
+
+func TestParse_Push(t *testing.T) {
+	event, err := Parse(EventRepoPush, []byte(pushPayload))
+	assert.Nil(t, err)
+
+	push, ok := event.(*PushEvent)
+	assert.True(t, ok)
+	assert.Equal(t, "owner/repo", push.Repository.GetFullName())
+	assert.Equal(t, "Jane", push.Actor.GetDisplayName())
+	assert.Len(t, push.Push.Changes, 1)
+
+	change := push.Push.Changes[0]
+	assert.Equal(t, "aaa", change.Old.GetTarget().GetHash())
+	assert.Equal(t, "bbb", change.New.GetTarget().GetHash())
+	assert.Equal(t, "Fix", change.Commits[0].GetMessage())
+	assert.Equal(t, "https://bitbucket.org/owner/repo/branches/compare/bbb..aaa", change.Links.Diff.GetHRef())
+}
+
+func TestParseRequest_PullRequestApproved(t *testing.T) {
+	body := `{"pullrequest": {"id": 3, "title": "Add feature"}, "approval": {"date": "2020-01-02T03:04:05Z", "user": {"uuid": "{u-2}"}}}`
+	r := httptest.NewRequest("POST", "/hook", strings.NewReader(body))
+	r.Header.Set(EventKeyHeader, EventPullRequestApproved)
+
+	event, err := ParseRequest(r)
+	assert.Nil(t, err)
+
+	approval, ok := event.(*PullRequestApprovalEvent)
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), approval.PullRequest.GetID())
+	assert.Equal(t, "{u-2}", approval.Approval.User.GetUUID())
+	assert.Equal(t, 2020, approval.Approval.Date.Year())
+}
+
+func TestParseRequest_Errors(t *testing.T) {
+	r := httptest.NewRequest("POST", "/hook", strings.NewReader(`{}`))
+	_, err := ParseRequest(r)
+	assert.Equal(t, ErrMissingEventKey, err)
+
+	_, err = Parse("repo:imported", []byte(`{}`))
+	assert.EqualError(t, err, `webhook: unknown event key "repo:imported"`)
+	assert.IsType(t, &UnknownEventError{}, err)
+
+	_, err = Parse(EventIssueCreated, []byte(`{"issue": []}`))
+	assert.Contains(t, err.Error(), "webhook: decoding issue:created payload")
+}